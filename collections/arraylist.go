@@ -1,7 +1,12 @@
 package collections
 
 import (
+	"errors"
 	"reflect"
+
+	"github.com/ovargas/go-lib/compare"
+	"github.com/ovargas/go-lib/optional"
+	"github.com/ovargas/go-lib/slice"
 )
 
 type (
@@ -19,6 +24,10 @@ type (
 
 var _ List[any] = (*ArrayList[any])(nil)
 
+var (
+	ErrIndexOutOfBounds = errors.New("index out of bounds")
+)
+
 // NewArrayList returns a new ArrayList.
 func NewArrayList[T any]() *ArrayList[T] {
 	var a = &ArrayList[T]{
@@ -47,8 +56,7 @@ func (a *ArrayList[T]) AddAt(i int, t T) bool {
 	if i < 0 || i > len(a.elements) {
 		return false
 	}
-	a.elements = append(a.elements[:i+1], a.elements[i:]...)
-	a.elements[i] = t
+	a.elements = insertAt(a.elements, i, t)
 	return true
 }
 
@@ -132,6 +140,65 @@ func (a *ArrayList[T]) Set(i int, t T) T {
 	return old
 }
 
+// TryGet returns the element at the specified position in this list, or ErrIndexOutOfBounds if the position is out of range.
+func (a *ArrayList[T]) TryGet(i int) (T, error) {
+	if !a.inBounds(i) {
+		var zero T
+		return zero, ErrIndexOutOfBounds
+	}
+	return a.elements[i], nil
+}
+
+// TrySet replaces the element at the specified position in this list with the specified element and returns the
+// replaced element, or ErrIndexOutOfBounds if the position is out of range.
+func (a *ArrayList[T]) TrySet(i int, t T) (T, error) {
+	if !a.inBounds(i) {
+		var zero T
+		return zero, ErrIndexOutOfBounds
+	}
+	return a.Set(i, t), nil
+}
+
+// TryRemoveAt removes the element at the specified position in this list and returns it, or ErrIndexOutOfBounds if
+// the position is out of range.
+func (a *ArrayList[T]) TryRemoveAt(i int) (T, error) {
+	if !a.inBounds(i) {
+		var zero T
+		return zero, ErrIndexOutOfBounds
+	}
+	return a.RemoveAt(i), nil
+}
+
+// First returns the first element of this list, or an empty Optional if this list is empty.
+func (a *ArrayList[T]) First() *optional.Optional[T] {
+	if len(a.elements) == 0 {
+		return optional.Empty[T]()
+	}
+	return optional.Of(a.elements[0])
+}
+
+// Last returns the last element of this list, or an empty Optional if this list is empty.
+func (a *ArrayList[T]) Last() *optional.Optional[T] {
+	if len(a.elements) == 0 {
+		return optional.Empty[T]()
+	}
+	return optional.Of(a.elements[len(a.elements)-1])
+}
+
+// FindFirst returns the first element that satisfies the given predicate, or an empty Optional if there is none.
+func (a *ArrayList[T]) FindFirst(f Predicate[T]) *optional.Optional[T] {
+	for _, e := range a.elements {
+		if f(e) {
+			return optional.Of(e)
+		}
+	}
+	return optional.Empty[T]()
+}
+
+func (a *ArrayList[T]) inBounds(i int) bool {
+	return i >= 0 && i < len(a.elements)
+}
+
 // ToArray returns an array containing all the elements in this list in proper sequence.
 func (a *ArrayList[T]) ToArray() []T {
 	arrays := make([]T, a.Size())
@@ -144,6 +211,37 @@ func (a *ArrayList[T]) Iterator() Iterator[T] {
 	return IteratorFromSlice[T](a.elements)
 }
 
+// Sort sorts this list with the comparator, the sort is not guaranteed to be stable.
+func (a *ArrayList[T]) Sort(c compare.Comparator[T]) {
+	slice.Sort(a.elements, c)
+}
+
+// SortStable sorts this list with the comparator, keeping the original order of equal elements.
+func (a *ArrayList[T]) SortStable(c compare.Comparator[T]) {
+	slice.SortStable(a.elements, c)
+}
+
+// BinarySearch searches x in this list, which must be sorted by the comparator. It returns the position where
+// x is found, or where it would be inserted, and whether it was found.
+func (a *ArrayList[T]) BinarySearch(x T, c compare.Comparator[T]) (int, bool) {
+	return slice.BinarySearch(a.elements, x, c)
+}
+
+// Reverse reverses the order of the elements of this list.
+func (a *ArrayList[T]) Reverse() {
+	for i, j := 0, len(a.elements)-1; i < j; i, j = i+1, j-1 {
+		a.elements[i], a.elements[j] = a.elements[j], a.elements[i]
+	}
+}
+
+func insertAt[T any](elements []T, i int, t T) []T {
+	var zero T
+	elements = append(elements, zero)
+	copy(elements[i+1:], elements[i:])
+	elements[i] = t
+	return elements
+}
+
 // Equal returns true if the two values are equal.
 func Equal[T any](a, b T) bool {
 	return reflect.DeepEqual(a, b)
@@ -162,3 +162,108 @@ func TestArrayList_WithElements(t *testing.T) {
 	assert.Equal(t, 6, list.Size())
 	assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, list.ToArray())
 }
+
+func TestArrayList_Sort(t *testing.T) {
+	list := NewArrayListWithElements([]int{3, 1, 2})
+
+	list.Sort(func(a, b int) int { return a - b })
+
+	assert.Equal(t, []int{1, 2, 3}, list.ToArray())
+}
+
+func TestArrayList_SortStable(t *testing.T) {
+	list := NewArrayListWithElements([]string{"bb", "a", "cc", "b", "aa"})
+
+	list.SortStable(func(a, b string) int { return len(a) - len(b) })
+
+	assert.Equal(t, []string{"a", "b", "bb", "cc", "aa"}, list.ToArray())
+}
+
+func TestArrayList_BinarySearch(t *testing.T) {
+	list := NewArrayListWithElements([]int{1, 3, 5})
+	cmp := func(a, b int) int { return a - b }
+
+	i, found := list.BinarySearch(3, cmp)
+	assert.True(t, found)
+	assert.Equal(t, 1, i)
+
+	i, found = list.BinarySearch(4, cmp)
+	assert.False(t, found)
+	assert.Equal(t, 2, i)
+}
+
+func TestArrayList_Reverse(t *testing.T) {
+	list := NewArrayListWithElements([]int{1, 2, 3, 4})
+
+	list.Reverse()
+
+	assert.Equal(t, []int{4, 3, 2, 1}, list.ToArray())
+}
+
+func TestArrayList_AddAt_End(t *testing.T) {
+	list := NewArrayList[int]()
+
+	assert.True(t, list.AddAt(0, 1))
+	assert.True(t, list.AddAt(1, 3))
+	assert.True(t, list.AddAt(1, 2))
+	assert.False(t, list.AddAt(4, 4))
+	assert.False(t, list.AddAt(-1, 4))
+
+	assert.Equal(t, []int{1, 2, 3}, list.ToArray())
+}
+
+func TestArrayList_TryGet(t *testing.T) {
+	list := NewArrayListWithElements([]int{1, 2, 3})
+
+	v, err := list.TryGet(1)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, v)
+
+	_, err = list.TryGet(3)
+	assert.ErrorIs(t, err, ErrIndexOutOfBounds)
+	_, err = list.TryGet(-1)
+	assert.ErrorIs(t, err, ErrIndexOutOfBounds)
+}
+
+func TestArrayList_TrySet(t *testing.T) {
+	list := NewArrayListWithElements([]int{1, 2, 3})
+
+	old, err := list.TrySet(1, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, old)
+
+	_, err = list.TrySet(3, 5)
+	assert.ErrorIs(t, err, ErrIndexOutOfBounds)
+	assert.Equal(t, []int{1, 5, 3}, list.ToArray())
+}
+
+func TestArrayList_TryRemoveAt(t *testing.T) {
+	list := NewArrayListWithElements([]int{1, 2, 3})
+
+	v, err := list.TryRemoveAt(0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, v)
+
+	_, err = list.TryRemoveAt(2)
+	assert.ErrorIs(t, err, ErrIndexOutOfBounds)
+	assert.Equal(t, []int{2, 3}, list.ToArray())
+}
+
+func TestArrayList_First_Last(t *testing.T) {
+	list := NewArrayList[int]()
+
+	assert.False(t, list.First().IsPresent())
+	assert.False(t, list.Last().IsPresent())
+
+	list.AddAll([]int{1, 2, 3})
+
+	assert.Equal(t, 1, list.First().Get())
+	assert.Equal(t, 3, list.Last().Get())
+}
+
+func TestArrayList_FindFirst(t *testing.T) {
+	list := NewArrayListWithElements([]int{1, 2, 3, 4})
+
+	assert.Equal(t, 2, list.FindFirst(func(i int) bool { return i%2 == 0 }).Get())
+	assert.False(t, list.FindFirst(func(i int) bool { return i > 4 }).IsPresent())
+}
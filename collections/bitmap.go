@@ -0,0 +1,601 @@
+package collections
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/bits"
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+type (
+
+	// Bitmap is a Set of unsigned integers backed by compressed bitmaps. The values are split by their high bits into
+	// chunks of 65536 values, each stored as a sorted array while it is sparse and as a bitmap when it is dense.
+	Bitmap[T constraints.Unsigned] struct {
+		keys       []uint64
+		containers []*container
+	}
+
+	// container holds the low 16 bits of the values of a chunk, in array while it has up to arrayMaxSize values and
+	// in bitmap beyond. A bitmap goes back to an array only below bitmapMinSize values, so a chunk whose size hovers
+	// around the threshold is not converted on every change.
+	container struct {
+		array       []uint16
+		bitmap      []uint64
+		cardinality int
+	}
+)
+
+const (
+	arrayMaxSize  = 4096
+	bitmapMinSize = 3072
+	bitmapWords   = 1 << 16 / 64
+	bitmapMagic   = 'R'
+)
+
+var _ Set[uint32] = (*Bitmap[uint32])(nil)
+
+var (
+	ErrInvalidBitmap = errors.New("invalid encoded bitmap")
+)
+
+// NewBitmap returns a new empty Bitmap.
+func NewBitmap[T constraints.Unsigned]() *Bitmap[T] {
+	return &Bitmap[T]{}
+}
+
+// NewBitmapWithElements returns a new Bitmap with the specified elements.
+func NewBitmapWithElements[T constraints.Unsigned](elements []T) *Bitmap[T] {
+	b := NewBitmap[T]()
+	b.AddAll(elements)
+	return b
+}
+
+// Add adds the specified element to this set, it returns false if the element was already present.
+func (b *Bitmap[T]) Add(t T) bool {
+	hi, lo := split(t)
+	i, found := b.find(hi)
+	if !found {
+		b.keys = insertAt(b.keys, i, hi)
+		b.containers = insertAt(b.containers, i, &container{})
+	}
+	return b.containers[i].add(lo)
+}
+
+// AddAll adds all the elements in the specified collection to this set.
+func (b *Bitmap[T]) AddAll(ts []T) bool {
+	for _, t := range ts {
+		b.Add(t)
+	}
+	return true
+}
+
+// Remove removes the specified element from this set, if it is present.
+func (b *Bitmap[T]) Remove(t T) bool {
+	hi, lo := split(t)
+	i, found := b.find(hi)
+	if !found || !b.containers[i].remove(lo) {
+		return false
+	}
+	if b.containers[i].cardinality == 0 {
+		b.removeContainer(i)
+	}
+	return true
+}
+
+// RemoveIf removes all the elements that satisfy the given predicate.
+func (b *Bitmap[T]) RemoveIf(f Predicate[T]) bool {
+	removed := false
+	for _, t := range b.ToArray() {
+		if f(t) {
+			b.Remove(t)
+			removed = true
+		}
+	}
+	return removed
+}
+
+// Clear removes all the elements from this set.
+func (b *Bitmap[T]) Clear() {
+	b.keys = nil
+	b.containers = nil
+}
+
+// Contains returns true if this set contains the specified element.
+func (b *Bitmap[T]) Contains(t T) bool {
+	hi, lo := split(t)
+	i, found := b.find(hi)
+	return found && b.containers[i].contains(lo)
+}
+
+// Size returns the number of elements in this set.
+func (b *Bitmap[T]) Size() int {
+	size := 0
+	for _, c := range b.containers {
+		size += c.cardinality
+	}
+	return size
+}
+
+// IsEmpty returns true if this set contains no elements.
+func (b *Bitmap[T]) IsEmpty() bool {
+	return len(b.containers) == 0
+}
+
+// ToArray returns an array containing all the elements in this set in ascending order.
+func (b *Bitmap[T]) ToArray() []T {
+	array := make([]T, 0, b.Size())
+	for i, c := range b.containers {
+		high := b.keys[i] << 16
+		c.each(func(lo uint16) {
+			array = append(array, T(high|uint64(lo)))
+		})
+	}
+	return array
+}
+
+// Iterator returns an iterator over the elements in this set in ascending order.
+func (b *Bitmap[T]) Iterator() Iterator[T] {
+	return IteratorFromSlice(b.ToArray())
+}
+
+// Rank returns the number of elements in this set that are lower than or equal to x.
+func (b *Bitmap[T]) Rank(x T) int {
+	hi, lo := split(x)
+	rank := 0
+	for i, key := range b.keys {
+		if key > hi {
+			break
+		}
+		if key == hi {
+			return rank + b.containers[i].rank(lo)
+		}
+		rank += b.containers[i].cardinality
+	}
+	return rank
+}
+
+// Select returns the element at position i of this set in ascending order, or ErrIndexOutOfBounds if i is out of range.
+func (b *Bitmap[T]) Select(i int) (T, error) {
+	if i >= 0 {
+		for k, c := range b.containers {
+			if i < c.cardinality {
+				return T(b.keys[k]<<16 | uint64(c.selectAt(i))), nil
+			}
+			i -= c.cardinality
+		}
+	}
+	return 0, ErrIndexOutOfBounds
+}
+
+// Union returns a new set with the elements that are in this set or in the other.
+func (b *Bitmap[T]) Union(other *Bitmap[T]) *Bitmap[T] {
+	result := NewBitmap[T]()
+	i, j := 0, 0
+	for i < len(b.keys) || j < len(other.keys) {
+		switch {
+		case j == len(other.keys) || (i < len(b.keys) && b.keys[i] < other.keys[j]):
+			result.appendContainer(b.keys[i], b.containers[i].clone())
+			i++
+		case i == len(b.keys) || other.keys[j] < b.keys[i]:
+			result.appendContainer(other.keys[j], other.containers[j].clone())
+			j++
+		default:
+			result.appendContainer(b.keys[i], b.containers[i].union(other.containers[j]))
+			i++
+			j++
+		}
+	}
+	return result
+}
+
+// Intersection returns a new set with the elements that are in both this set and the other.
+func (b *Bitmap[T]) Intersection(other *Bitmap[T]) *Bitmap[T] {
+	result := NewBitmap[T]()
+	for i, j := 0, 0; i < len(b.keys) && j < len(other.keys); {
+		switch {
+		case b.keys[i] < other.keys[j]:
+			i++
+		case other.keys[j] < b.keys[i]:
+			j++
+		default:
+			result.appendContainer(b.keys[i], b.containers[i].intersection(other.containers[j]))
+			i++
+			j++
+		}
+	}
+	return result
+}
+
+// Difference returns a new set with the elements of this set that are not in the other.
+func (b *Bitmap[T]) Difference(other *Bitmap[T]) *Bitmap[T] {
+	result := NewBitmap[T]()
+	j := 0
+	for i, key := range b.keys {
+		for j < len(other.keys) && other.keys[j] < key {
+			j++
+		}
+		if j < len(other.keys) && other.keys[j] == key {
+			result.appendContainer(key, b.containers[i].difference(other.containers[j]))
+			continue
+		}
+		result.appendContainer(key, b.containers[i].clone())
+	}
+	return result
+}
+
+// MarshalBinary encodes the set, the chunks with up to 4096 values are stored as 16-bit values and the others as
+// 64-bit words.
+func (b *Bitmap[T]) MarshalBinary() ([]byte, error) {
+	data := []byte{bitmapMagic}
+	data = binary.AppendUvarint(data, uint64(len(b.keys)))
+	for i, key := range b.keys {
+		c := b.containers[i]
+		data = binary.AppendUvarint(data, key)
+		data = binary.AppendUvarint(data, uint64(c.cardinality))
+		if c.cardinality > arrayMaxSize {
+			for _, w := range c.bitmap {
+				data = binary.LittleEndian.AppendUint64(data, w)
+			}
+			continue
+		}
+		c.each(func(v uint16) {
+			data = binary.LittleEndian.AppendUint16(data, v)
+		})
+	}
+	return data, nil
+}
+
+// UnmarshalBinary replaces the elements of the set with the elements encoded by MarshalBinary. It returns
+// ErrInvalidBitmap if the data is malformed or holds values that do not fit in T.
+func (b *Bitmap[T]) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 || data[0] != bitmapMagic {
+		return ErrInvalidBitmap
+	}
+	data = data[1:]
+	next := func() (uint64, bool) {
+		v, n := binary.Uvarint(data)
+		if n <= 0 {
+			return 0, false
+		}
+		data = data[n:]
+		return v, true
+	}
+
+	count, ok := next()
+	if !ok {
+		return ErrInvalidBitmap
+	}
+	maxKey, maxLow := split(^T(0))
+	result := NewBitmap[T]()
+	for k := uint64(0); k < count; k++ {
+		key, ok := next()
+		if !ok || key > maxKey || (len(result.keys) > 0 && key <= result.keys[len(result.keys)-1]) {
+			return ErrInvalidBitmap
+		}
+		cardinality, ok := next()
+		if !ok || cardinality == 0 || cardinality > 1<<16 {
+			return ErrInvalidBitmap
+		}
+
+		c := &container{cardinality: int(cardinality)}
+		if cardinality > arrayMaxSize {
+			if len(data) < bitmapWords*8 {
+				return ErrInvalidBitmap
+			}
+			c.bitmap = make([]uint64, bitmapWords)
+			for i := range c.bitmap {
+				c.bitmap[i] = binary.LittleEndian.Uint64(data[i*8:])
+			}
+			data = data[bitmapWords*8:]
+			if c.count() != c.cardinality {
+				return ErrInvalidBitmap
+			}
+		} else {
+			if uint64(len(data)) < cardinality*2 {
+				return ErrInvalidBitmap
+			}
+			c.array = make([]uint16, cardinality)
+			for i := range c.array {
+				c.array[i] = binary.LittleEndian.Uint16(data[i*2:])
+				if i > 0 && c.array[i] <= c.array[i-1] {
+					return ErrInvalidBitmap
+				}
+			}
+			data = data[cardinality*2:]
+		}
+		if key == maxKey && c.max() > maxLow {
+			return ErrInvalidBitmap
+		}
+		result.appendContainer(key, c)
+	}
+	if len(data) != 0 {
+		return ErrInvalidBitmap
+	}
+	*b = *result
+	return nil
+}
+
+func split[T constraints.Unsigned](t T) (uint64, uint16) {
+	return uint64(t) >> 16, uint16(t)
+}
+
+func (b *Bitmap[T]) find(key uint64) (int, bool) {
+	i := sort.Search(len(b.keys), func(i int) bool {
+		return b.keys[i] >= key
+	})
+	return i, i < len(b.keys) && b.keys[i] == key
+}
+
+func (b *Bitmap[T]) removeContainer(i int) {
+	b.keys = append(b.keys[:i], b.keys[i+1:]...)
+	b.containers = append(b.containers[:i], b.containers[i+1:]...)
+}
+
+// appendContainer appends a container with a key greater than any other, empty containers are discarded.
+func (b *Bitmap[T]) appendContainer(key uint64, c *container) {
+	if c.cardinality == 0 {
+		return
+	}
+	b.keys = append(b.keys, key)
+	b.containers = append(b.containers, c)
+}
+
+func (c *container) add(v uint16) bool {
+	if c.bitmap != nil {
+		if c.bitmap[v/64]&(1<<(v%64)) != 0 {
+			return false
+		}
+		c.bitmap[v/64] |= 1 << (v % 64)
+		c.cardinality++
+		return true
+	}
+
+	i, found := c.search(v)
+	if found {
+		return false
+	}
+	c.array = insertAt(c.array, i, v)
+	c.cardinality++
+	if c.cardinality > arrayMaxSize {
+		c.toBitmap()
+	}
+	return true
+}
+
+func (c *container) remove(v uint16) bool {
+	if c.bitmap != nil {
+		if c.bitmap[v/64]&(1<<(v%64)) == 0 {
+			return false
+		}
+		c.bitmap[v/64] &^= 1 << (v % 64)
+		c.cardinality--
+		if c.cardinality < bitmapMinSize {
+			c.toArray()
+		}
+		return true
+	}
+
+	i, found := c.search(v)
+	if !found {
+		return false
+	}
+	c.array = append(c.array[:i], c.array[i+1:]...)
+	c.cardinality--
+	return true
+}
+
+func (c *container) contains(v uint16) bool {
+	if c.bitmap != nil {
+		return c.bitmap[v/64]&(1<<(v%64)) != 0
+	}
+	_, found := c.search(v)
+	return found
+}
+
+func (c *container) search(v uint16) (int, bool) {
+	i := sort.Search(len(c.array), func(i int) bool {
+		return c.array[i] >= v
+	})
+	return i, i < len(c.array) && c.array[i] == v
+}
+
+// rank returns the number of values lower than or equal to v.
+func (c *container) rank(v uint16) int {
+	if c.bitmap != nil {
+		rank := 0
+		for _, w := range c.bitmap[:v/64] {
+			rank += bits.OnesCount64(w)
+		}
+		return rank + bits.OnesCount64(c.bitmap[v/64]<<(63-v%64))
+	}
+	i, found := c.search(v)
+	if found {
+		return i + 1
+	}
+	return i
+}
+
+func (c *container) selectAt(i int) uint16 {
+	if c.bitmap == nil {
+		return c.array[i]
+	}
+	for k, w := range c.bitmap {
+		n := bits.OnesCount64(w)
+		if i >= n {
+			i -= n
+			continue
+		}
+		for ; i > 0; i-- {
+			w &= w - 1
+		}
+		return uint16(k*64 + bits.TrailingZeros64(w))
+	}
+	panic(ErrIndexOutOfBounds)
+}
+
+// max returns the greatest value of a non empty container.
+func (c *container) max() uint16 {
+	if c.bitmap == nil {
+		return c.array[len(c.array)-1]
+	}
+	for k := len(c.bitmap) - 1; ; k-- {
+		if w := c.bitmap[k]; w != 0 {
+			return uint16(k*64 + 63 - bits.LeadingZeros64(w))
+		}
+	}
+}
+
+func (c *container) each(fn func(uint16)) {
+	if c.bitmap == nil {
+		for _, v := range c.array {
+			fn(v)
+		}
+		return
+	}
+	for k, w := range c.bitmap {
+		for w != 0 {
+			fn(uint16(k*64 + bits.TrailingZeros64(w)))
+			w &= w - 1
+		}
+	}
+}
+
+func (c *container) clone() *container {
+	result := &container{cardinality: c.cardinality}
+	if c.bitmap != nil {
+		result.bitmap = make([]uint64, bitmapWords)
+		copy(result.bitmap, c.bitmap)
+		return result
+	}
+	result.array = make([]uint16, len(c.array))
+	copy(result.array, c.array)
+	return result
+}
+
+func (c *container) union(other *container) *container {
+	if c.bitmap == nil && other.bitmap == nil && c.cardinality+other.cardinality <= arrayMaxSize {
+		array := make([]uint16, 0, c.cardinality+other.cardinality)
+		i, j := 0, 0
+		for i < len(c.array) && j < len(other.array) {
+			switch {
+			case c.array[i] < other.array[j]:
+				array = append(array, c.array[i])
+				i++
+			case other.array[j] < c.array[i]:
+				array = append(array, other.array[j])
+				j++
+			default:
+				array = append(array, c.array[i])
+				i++
+				j++
+			}
+		}
+		array = append(array, c.array[i:]...)
+		array = append(array, other.array[j:]...)
+		return &container{array: array, cardinality: len(array)}
+	}
+
+	result := c.bitmapCopy()
+	if other.bitmap != nil {
+		for i, w := range other.bitmap {
+			result.bitmap[i] |= w
+		}
+	} else {
+		for _, v := range other.array {
+			result.bitmap[v/64] |= 1 << (v % 64)
+		}
+	}
+	return result.normalize()
+}
+
+func (c *container) intersection(other *container) *container {
+	switch {
+	case c.bitmap == nil:
+		return c.filter(other.contains)
+	case other.bitmap == nil:
+		return other.filter(c.contains)
+	}
+	result := c.bitmapCopy()
+	for i, w := range other.bitmap {
+		result.bitmap[i] &= w
+	}
+	return result.normalize()
+}
+
+func (c *container) difference(other *container) *container {
+	if c.bitmap == nil {
+		return c.filter(func(v uint16) bool {
+			return !other.contains(v)
+		})
+	}
+	result := c.bitmapCopy()
+	if other.bitmap != nil {
+		for i, w := range other.bitmap {
+			result.bitmap[i] &^= w
+		}
+	} else {
+		for _, v := range other.array {
+			result.bitmap[v/64] &^= 1 << (v % 64)
+		}
+	}
+	return result.normalize()
+}
+
+// filter returns a new array container with the values of an array container that satisfy the predicate.
+func (c *container) filter(f func(uint16) bool) *container {
+	array := make([]uint16, 0)
+	for _, v := range c.array {
+		if f(v) {
+			array = append(array, v)
+		}
+	}
+	return &container{array: array, cardinality: len(array)}
+}
+
+// bitmapCopy returns a copy of the container as a bitmap, its cardinality must be recomputed with normalize.
+func (c *container) bitmapCopy() *container {
+	if c.bitmap != nil {
+		return c.clone()
+	}
+	result := &container{bitmap: make([]uint64, bitmapWords)}
+	for _, v := range c.array {
+		result.bitmap[v/64] |= 1 << (v % 64)
+	}
+	return result
+}
+
+// normalize recomputes the cardinality of a bitmap container and converts it to an array if it is sparse.
+func (c *container) normalize() *container {
+	c.cardinality = c.count()
+	if c.cardinality <= arrayMaxSize {
+		c.toArray()
+	}
+	return c
+}
+
+func (c *container) count() int {
+	count := 0
+	for _, w := range c.bitmap {
+		count += bits.OnesCount64(w)
+	}
+	return count
+}
+
+func (c *container) toBitmap() {
+	bitmap := make([]uint64, bitmapWords)
+	for _, v := range c.array {
+		bitmap[v/64] |= 1 << (v % 64)
+	}
+	c.bitmap, c.array = bitmap, nil
+}
+
+func (c *container) toArray() {
+	array := make([]uint16, 0, c.cardinality)
+	c.each(func(v uint16) {
+		array = append(array, v)
+	})
+	c.array, c.bitmap = array, nil
+}
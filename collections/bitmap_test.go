@@ -0,0 +1,201 @@
+package collections
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestBitmap_Add(t *testing.T) {
+	b := NewBitmap[uint32]()
+
+	assert.True(t, b.Add(70000))
+	assert.True(t, b.Add(1))
+	assert.False(t, b.Add(1))
+	assert.True(t, b.Add(65535))
+
+	assert.Equal(t, 3, b.Size())
+	assert.Equal(t, []uint32{1, 65535, 70000}, b.ToArray())
+	assert.True(t, b.Contains(70000))
+	assert.False(t, b.Contains(2))
+}
+
+func TestBitmap_Remove(t *testing.T) {
+	b := NewBitmapWithElements([]uint64{1, 2, 1 << 40})
+
+	assert.True(t, b.Remove(1<<40))
+	assert.False(t, b.Remove(1<<40))
+	assert.True(t, b.RemoveIf(func(v uint64) bool { return v == 2 }))
+
+	assert.Equal(t, []uint64{1}, b.ToArray())
+	b.Clear()
+	assert.True(t, b.IsEmpty())
+}
+
+func TestBitmap_DenseContainer(t *testing.T) {
+	b := NewBitmap[uint32]()
+	for v := uint32(0); v < 10000; v += 2 {
+		b.Add(v)
+	}
+	assert.NotNil(t, b.containers[0].bitmap)
+	assert.Equal(t, 5000, b.Size())
+
+	for v := uint32(0); v < 2000; v += 2 {
+		b.Remove(v)
+	}
+	assert.NotNil(t, b.containers[0].bitmap)
+	assert.Equal(t, 4000, b.Size())
+
+	for v := uint32(2000); v < 4000; v += 2 {
+		b.Remove(v)
+	}
+	assert.Nil(t, b.containers[0].bitmap)
+	assert.Equal(t, 3000, b.Size())
+	assert.True(t, b.Contains(4000))
+	assert.False(t, b.Contains(3998))
+}
+
+func TestBitmap_ContainerHysteresis(t *testing.T) {
+	b := NewBitmap[uint32]()
+	for v := uint32(0); v <= arrayMaxSize; v++ {
+		b.Add(v)
+	}
+	assert.NotNil(t, b.containers[0].bitmap)
+
+	// Hovering around the threshold keeps the container as a bitmap.
+	for i := 0; i < 10; i++ {
+		b.Remove(0)
+		assert.NotNil(t, b.containers[0].bitmap)
+		b.Add(0)
+		assert.NotNil(t, b.containers[0].bitmap)
+	}
+
+	// A bitmap between the thresholds is encoded as an array and decoded back to one.
+	for v := uint32(0); v < 1000; v++ {
+		b.Remove(v)
+	}
+	assert.NotNil(t, b.containers[0].bitmap)
+	data, err := b.MarshalBinary()
+	assert.NoError(t, err)
+	actual := NewBitmap[uint32]()
+	assert.NoError(t, actual.UnmarshalBinary(data))
+	assert.Nil(t, actual.containers[0].bitmap)
+	assert.Equal(t, b.ToArray(), actual.ToArray())
+}
+
+func TestBitmap_RankSelect(t *testing.T) {
+	for _, step := range []uint32{2, 7, 100} {
+		b := NewBitmap[uint32]()
+		var values []uint32
+		for v := uint32(5); v < 200000; v += step {
+			b.Add(v)
+			values = append(values, v)
+		}
+
+		for i, v := range values {
+			if i%97 != 0 {
+				continue
+			}
+			assert.Equal(t, i+1, b.Rank(v))
+			assert.Equal(t, i, b.Rank(v-1))
+			actual, err := b.Select(i)
+			assert.NoError(t, err)
+			assert.Equal(t, v, actual)
+		}
+		assert.Equal(t, 0, b.Rank(4))
+		assert.Equal(t, len(values), b.Rank(1<<31))
+
+		_, err := b.Select(len(values))
+		assert.ErrorIs(t, err, ErrIndexOutOfBounds)
+		_, err = b.Select(-1)
+		assert.ErrorIs(t, err, ErrIndexOutOfBounds)
+	}
+}
+
+func TestBitmap_SetOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, limit := range []int{1000, 30000, 200000} {
+		a, b := NewBitmap[uint32](), NewBitmap[uint32]()
+		sa, sb := NewValueSet[uint32](), NewValueSet[uint32]()
+		for i := 0; i < 20000; i++ {
+			x, y := uint32(r.Intn(limit)), uint32(r.Intn(limit))
+			a.Add(x)
+			sa.Add(x)
+			b.Add(y)
+			sb.Add(y)
+		}
+
+		union, intersection, difference := NewValueSet[uint32](), NewValueSet[uint32](), NewValueSet[uint32]()
+		union.AddAll(sa.ToArray())
+		union.AddAll(sb.ToArray())
+		for _, v := range sa.ToArray() {
+			if sb.Contains(v) {
+				intersection.Add(v)
+			} else {
+				difference.Add(v)
+			}
+		}
+
+		assert.Equal(t, sorted(union.ToArray()), a.Union(b).ToArray())
+		assert.Equal(t, sorted(intersection.ToArray()), a.Intersection(b).ToArray())
+		assert.Equal(t, sorted(difference.ToArray()), a.Difference(b).ToArray())
+		assert.Equal(t, sorted(sa.ToArray()), a.ToArray())
+	}
+}
+
+func TestBitmap_Binary(t *testing.T) {
+	b := NewBitmap[uint64]()
+	for v := uint64(0); v < 10000; v += 2 {
+		b.Add(v)
+	}
+	b.AddAll([]uint64{1 << 20, 1 << 40})
+
+	data, err := b.MarshalBinary()
+	assert.NoError(t, err)
+
+	actual := NewBitmap[uint64]()
+	assert.NoError(t, actual.UnmarshalBinary(data))
+	assert.Equal(t, b.ToArray(), actual.ToArray())
+
+	assert.ErrorIs(t, actual.UnmarshalBinary(data[:len(data)-1]), ErrInvalidBitmap)
+	assert.ErrorIs(t, actual.UnmarshalBinary(nil), ErrInvalidBitmap)
+}
+
+func TestBitmap_Binary_KeyWidth(t *testing.T) {
+	wide, err := NewBitmapWithElements([]uint32{1, 1 << 16}).MarshalBinary()
+	assert.NoError(t, err)
+	assert.ErrorIs(t, NewBitmap[uint16]().UnmarshalBinary(wide), ErrInvalidBitmap)
+	assert.NoError(t, NewBitmap[uint32]().UnmarshalBinary(wide))
+
+	dense := NewBitmap[uint16]()
+	for v := uint16(0); v < 5000; v++ {
+		dense.Add(v)
+	}
+	for _, b := range []*Bitmap[uint16]{NewBitmapWithElements([]uint16{1, 256}), dense} {
+		data, err := b.MarshalBinary()
+		assert.NoError(t, err)
+		assert.ErrorIs(t, NewBitmap[uint8]().UnmarshalBinary(data), ErrInvalidBitmap)
+	}
+
+	narrow, err := NewBitmapWithElements([]uint16{1, 255}).MarshalBinary()
+	assert.NoError(t, err)
+	actual := NewBitmap[uint8]()
+	assert.NoError(t, actual.UnmarshalBinary(narrow))
+	assert.Equal(t, []uint8{1, 255}, actual.ToArray())
+}
+
+func TestBitmap_Iterator(t *testing.T) {
+	b := NewBitmapWithElements([]uint16{3, 1, 2})
+
+	var actual []uint16
+	for it := b.Iterator(); it.HasNext(); {
+		actual = append(actual, it.Next())
+	}
+	assert.Equal(t, []uint16{1, 2, 3}, actual)
+}
+
+func sorted(values []uint32) []uint32 {
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	return values
+}
@@ -1,22 +1,44 @@
 package collections
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/ovargas/go-lib/compare"
+	"github.com/ovargas/go-lib/slice"
+)
 
 type (
 
 	// Dictionary is an extension of a map to reduce the common boilerplate coding.
 	Dictionary[K comparable, T any] map[K]T
 
+	// Entry is a key/value pair of a Map.
 	Entry[K comparable, T any] struct {
 		key   K
 		value T
 	}
 )
 
+var _ Map[string, any] = (Dictionary[string, any])(nil)
+
 var (
 	ErrKeyNotFound = errors.New("key not found")
 )
 
+// NewEntry returns a new entry with the specified key and value
+func NewEntry[K comparable, T any](key K, value T) *Entry[K, T] {
+	return &Entry[K, T]{key: key, value: value}
+}
+
+// FromEntries returns a new dictionary with the specified entries, later entries overwrite earlier ones with the same key
+func FromEntries[K comparable, T any](entries []*Entry[K, T]) Dictionary[K, T] {
+	d := make(Dictionary[K, T], len(entries))
+	for _, e := range entries {
+		d[e.key] = e.value
+	}
+	return d
+}
+
 // Key returns the key of the entry
 func (e *Entry[K, T]) Key() K {
 	return e.key
@@ -80,14 +102,137 @@ func (d Dictionary[K, T]) Values() []T {
 	return values
 }
 
-// Merge merges the other dictionary into the dictionary
+// Size returns the number of entries in the dictionary
+func (d Dictionary[K, T]) Size() int {
+	return len(d)
+}
+
+// Entries returns the key/value pairs of the dictionary
+func (d Dictionary[K, T]) Entries() []*Entry[K, T] {
+	entries := make([]*Entry[K, T], 0, len(d))
+	for k, v := range d {
+		entries = append(entries, &Entry[K, T]{key: k, value: v})
+	}
+	return entries
+}
+
+// Merge merges the other dictionary into the dictionary, values of existing keys are overwritten
 func (d Dictionary[K, T]) Merge(other Dictionary[K, T]) {
 	for k, v := range other {
 		d[k] = v
 	}
 }
 
+// MergeWith merges the other dictionary into the dictionary, using the resolver to compute the value of keys present in both
+func (d Dictionary[K, T]) MergeWith(other Dictionary[K, T], resolver func(key K, current, other T) T) {
+	for k, v := range other {
+		if current, ok := d[k]; ok {
+			d[k] = resolver(k, current, v)
+			continue
+		}
+		d[k] = v
+	}
+}
+
+// PutIfAbsent sets the value of the key only if the key does not exist, returns true if the value was set
+func (d Dictionary[K, T]) PutIfAbsent(key K, value T) bool {
+	if _, ok := d[key]; ok {
+		return false
+	}
+	d[key] = value
+	return true
+}
+
+// ComputeIfAbsent returns the value of the key, computing and storing it with fn if the key does not exist
+func (d Dictionary[K, T]) ComputeIfAbsent(key K, fn func(K) T) T {
+	if v, ok := d[key]; ok {
+		return v
+	}
+	v := fn(key)
+	d[key] = v
+	return v
+}
+
+// ComputeIfPresent replaces the value of an existing key with the value returned by fn, the key is removed if fn
+// returns false. It returns the new value and whether the key is present after the call
+func (d Dictionary[K, T]) ComputeIfPresent(key K, fn func(K, T) (T, bool)) (T, bool) {
+	v, ok := d[key]
+	if !ok {
+		return v, false
+	}
+	v, keep := fn(key, v)
+	return d.apply(key, v, keep)
+}
+
+// Compute sets the value of the key to the value returned by fn, which receives the current value and whether the
+// key exists. The key is removed if fn returns false. It returns the new value and whether the key is present after the call
+func (d Dictionary[K, T]) Compute(key K, fn func(K, T, bool) (T, bool)) (T, bool) {
+	v, ok := d[key]
+	v, keep := fn(key, v, ok)
+	return d.apply(key, v, keep)
+}
+
+func (d Dictionary[K, T]) apply(key K, value T, keep bool) (T, bool) {
+	if !keep {
+		delete(d, key)
+		var zero T
+		return zero, false
+	}
+	d[key] = value
+	return value, true
+}
+
+// Filter returns a new dictionary with the entries that satisfy the predicate
+func (d Dictionary[K, T]) Filter(predicate func(K, T) bool) Dictionary[K, T] {
+	result := make(Dictionary[K, T])
+	for k, v := range d {
+		if predicate(k, v) {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// SortedKeys returns the keys of the dictionary sorted with the comparator
+func (d Dictionary[K, T]) SortedKeys(c compare.Comparator[K]) []K {
+	keys := d.Keys()
+	slice.Sort(keys, c)
+	return keys
+}
+
+// Equal returns true if both dictionaries have the same keys and the values of each key are equal according to eq
+func (d Dictionary[K, T]) Equal(other Dictionary[K, T], eq EqualFn[T]) bool {
+	if len(d) != len(other) {
+		return false
+	}
+	for k, v := range d {
+		o, ok := other[k]
+		if !ok || !eq(v, o) {
+			return false
+		}
+	}
+	return true
+}
+
+// MapValues returns a new dictionary with the same keys and the values transformed by fn
+func MapValues[K comparable, T any, R any](d Dictionary[K, T], fn func(T) R) Dictionary[K, R] {
+	result := make(Dictionary[K, R], len(d))
+	for k, v := range d {
+		result[k] = fn(v)
+	}
+	return result
+}
+
+// Invert returns a new dictionary with the keys and values swapped, if several keys share a value only one of them is kept
+func Invert[K comparable, T comparable](d Dictionary[K, T]) Dictionary[T, K] {
+	result := make(Dictionary[T, K], len(d))
+	for k, v := range d {
+		result[v] = k
+	}
+	return result
+}
+
 // Iterator returns an iterator for the dictionary
-func (d Dictionary[K, T]) Iterator() *ChannelIterator[*Entry[K, T]] {
+func (d Dictionary[K, T]) Iterator() Iterator[*Entry[K, T]] {
 	return IteratorFromMap[K, T](d)
 }
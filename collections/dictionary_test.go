@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	"sort"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -300,3 +302,141 @@ func TestDictionary_Iterator(t *testing.T) {
 
 	assert.Equalf(t, []string{"key+value", "key2+value2"}, result, "Iterator()")
 }
+
+func TestDictionary_Size(t *testing.T) {
+	assert.Equal(t, 0, Dictionary[string, string]{}.Size())
+	assert.Equal(t, 2, Dictionary[string, string]{"key": "value", "key2": "value2"}.Size())
+}
+
+func TestDictionary_Entries(t *testing.T) {
+	dictionary := Dictionary[string, string]{"key": "value", "key2": "value2"}
+
+	var result []string
+	for _, e := range dictionary.Entries() {
+		result = append(result, fmt.Sprintf("%s+%s", e.Key(), e.Value()))
+	}
+
+	// Sort the slices to make sure they are equal, the entries from the
+	// dictionary are not ensured to be in order.
+	sort.Strings(result)
+
+	assert.Equalf(t, []string{"key+value", "key2+value2"}, result, "Entries()")
+}
+
+func TestDictionary_AsMap(t *testing.T) {
+	var m Map[string, int] = Dictionary[string, int]{}
+	m.Set("a", 1)
+
+	assert.True(t, m.Has("a"))
+	assert.Equal(t, 1, m.Size())
+	assert.Equal(t, []string{"a"}, m.Keys())
+}
+
+func TestDictionary_MergeWith(t *testing.T) {
+	dictionary := Dictionary[string, int]{"a": 1, "b": 2}
+	dictionary.MergeWith(Dictionary[string, int]{"b": 3, "c": 4}, func(key string, current, other int) int {
+		return current + other
+	})
+
+	assert.Equal(t, Dictionary[string, int]{"a": 1, "b": 5, "c": 4}, dictionary)
+}
+
+func TestDictionary_PutIfAbsent(t *testing.T) {
+	dictionary := Dictionary[string, int]{"a": 1}
+
+	assert.False(t, dictionary.PutIfAbsent("a", 2))
+	assert.True(t, dictionary.PutIfAbsent("b", 2))
+	assert.Equal(t, Dictionary[string, int]{"a": 1, "b": 2}, dictionary)
+}
+
+func TestDictionary_ComputeIfAbsent(t *testing.T) {
+	dictionary := Dictionary[string, int]{"a": 1}
+	calls := 0
+	fn := func(key string) int {
+		calls++
+		return len(key)
+	}
+
+	assert.Equal(t, 1, dictionary.ComputeIfAbsent("a", fn))
+	assert.Equal(t, 3, dictionary.ComputeIfAbsent("abc", fn))
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, Dictionary[string, int]{"a": 1, "abc": 3}, dictionary)
+}
+
+func TestDictionary_ComputeIfPresent(t *testing.T) {
+	dictionary := Dictionary[string, int]{"a": 1, "b": 2}
+	increment := func(key string, v int) (int, bool) { return v + 1, true }
+	remove := func(key string, v int) (int, bool) { return 0, false }
+
+	v, ok := dictionary.ComputeIfPresent("a", increment)
+	assert.True(t, ok)
+	assert.Equal(t, 2, v)
+
+	_, ok = dictionary.ComputeIfPresent("c", increment)
+	assert.False(t, ok)
+
+	_, ok = dictionary.ComputeIfPresent("b", remove)
+	assert.False(t, ok)
+
+	assert.Equal(t, Dictionary[string, int]{"a": 2}, dictionary)
+}
+
+func TestDictionary_Compute(t *testing.T) {
+	dictionary := Dictionary[string, int]{}
+	count := func(key string, v int, ok bool) (int, bool) { return v + 1, true }
+
+	dictionary.Compute("a", count)
+	v, ok := dictionary.Compute("a", count)
+	assert.True(t, ok)
+	assert.Equal(t, 2, v)
+
+	_, ok = dictionary.Compute("a", func(key string, v int, ok bool) (int, bool) { return v, false })
+	assert.False(t, ok)
+	assert.False(t, dictionary.Has("a"))
+}
+
+func TestDictionary_Filter(t *testing.T) {
+	dictionary := Dictionary[string, int]{"a": 1, "b": 2, "c": 3}
+
+	actual := dictionary.Filter(func(key string, v int) bool { return v%2 == 1 })
+
+	assert.Equal(t, Dictionary[string, int]{"a": 1, "c": 3}, actual)
+	assert.Equal(t, 3, len(dictionary))
+}
+
+func TestDictionary_SortedKeys(t *testing.T) {
+	dictionary := Dictionary[string, int]{"b": 1, "c": 2, "a": 3}
+
+	actual := dictionary.SortedKeys(func(a, b string) int { return strings.Compare(b, a) })
+
+	assert.Equal(t, []string{"c", "b", "a"}, actual)
+}
+
+func TestDictionary_Equal(t *testing.T) {
+	eq := func(a, b int) bool { return a == b }
+
+	assert.True(t, Dictionary[string, int]{"a": 1}.Equal(Dictionary[string, int]{"a": 1}, eq))
+	assert.False(t, Dictionary[string, int]{"a": 1}.Equal(Dictionary[string, int]{"a": 2}, eq))
+	assert.False(t, Dictionary[string, int]{"a": 1}.Equal(Dictionary[string, int]{"b": 1}, eq))
+	assert.False(t, Dictionary[string, int]{"a": 1}.Equal(Dictionary[string, int]{}, eq))
+}
+
+func TestMapValues(t *testing.T) {
+	dictionary := Dictionary[string, int]{"a": 1, "b": 2}
+
+	actual := MapValues(dictionary, func(v int) string { return strconv.Itoa(v * 10) })
+
+	assert.Equal(t, Dictionary[string, string]{"a": "10", "b": "20"}, actual)
+}
+
+func TestInvert(t *testing.T) {
+	dictionary := Dictionary[string, int]{"a": 1, "b": 2}
+
+	assert.Equal(t, Dictionary[int, string]{1: "a", 2: "b"}, Invert(dictionary))
+}
+
+func TestFromEntries(t *testing.T) {
+	actual := FromEntries([]*Entry[string, int]{NewEntry("a", 1), NewEntry("b", 2), NewEntry("a", 3)})
+
+	assert.Equal(t, Dictionary[string, int]{"a": 3, "b": 2}, actual)
+}
@@ -0,0 +1,262 @@
+package collections
+
+import (
+	"errors"
+)
+
+type (
+
+	// edit is a removal of the element at position x of a, or an addition of the element at position y of b before
+	// the element at position x of a.
+	edit struct {
+		changeType ChangeType
+		x, y       int
+	}
+
+	// differ holds the state of Myers' algorithm, the diagonals are shared by every step of the recursion.
+	differ[T any] struct {
+		a, b     []T
+		equal    EqualFn[T]
+		forward  []int
+		backward []int
+		offset   int
+		edits    []edit
+	}
+)
+
+var (
+	ErrPatchConflict = errors.New("patch does not apply to the collection")
+)
+
+// DiffList returns the shortest edit script that turns the list a into the list b, computed with Myers' algorithm.
+// The key of a change is the position in a of the element it affects, an added element is inserted before the
+// element at that position. Consecutive removals and additions are reported as replacements.
+func DiffList[T comparable](a, b List[T]) []*Change[int, T] {
+	return DiffListFunc(a, b, func(x, y T) bool { return x == y })
+}
+
+// DiffListFunc is like DiffList but compares the elements with the equal function.
+func DiffListFunc[T any](a, b List[T], equal EqualFn[T]) []*Change[int, T] {
+	x, y := a.ToArray(), b.ToArray()
+
+	// Only the middle part that differs goes through the algorithm.
+	prefix := 0
+	for prefix < len(x) && prefix < len(y) && equal(x[prefix], y[prefix]) {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(x)-prefix && suffix < len(y)-prefix && equal(x[len(x)-1-suffix], y[len(y)-1-suffix]) {
+		suffix++
+	}
+
+	edits := myers(x[prefix:len(x)-suffix], y[prefix:len(y)-suffix], equal)
+	return coalesce(edits, x, y, prefix)
+}
+
+// DiffSet returns the changes that turn the set a into the set b, the key and the value of a change are the element.
+func DiffSet[T comparable](a, b Set[T]) []*Change[T, T] {
+	var changes []*Change[T, T]
+	for _, t := range a.ToArray() {
+		if !b.Contains(t) {
+			changes = append(changes, &Change[T, T]{changeType: ChangeRemoved, key: t, value: t})
+		}
+	}
+	for _, t := range b.ToArray() {
+		if !a.Contains(t) {
+			changes = append(changes, &Change[T, T]{changeType: ChangeAdded, key: t, value: t})
+		}
+	}
+	return changes
+}
+
+// DiffMap returns the changes that turn the map a into the map b, the values are compared with the equal function.
+func DiffMap[K comparable, T any](a, b Map[K, T], equal EqualFn[T]) []*Change[K, T] {
+	var changes []*Change[K, T]
+	for _, k := range a.Keys() {
+		old, _ := a.Get(k)
+		value, err := b.Get(k)
+		switch {
+		case err != nil:
+			changes = append(changes, &Change[K, T]{changeType: ChangeRemoved, key: k, value: old})
+		case !equal(old, value):
+			changes = append(changes, &Change[K, T]{changeType: ChangeReplaced, key: k, value: value, oldValue: old})
+		}
+	}
+	for _, k := range b.Keys() {
+		if !a.Has(k) {
+			value, _ := b.Get(k)
+			changes = append(changes, &Change[K, T]{changeType: ChangeAdded, key: k, value: value})
+		}
+	}
+	return changes
+}
+
+// PatchList applies the changes returned by DiffList to the list. It returns ErrPatchConflict if a change refers to a
+// position outside of the list or the list refuses an element, in which case the list may be partially patched.
+func PatchList[T any](l List[T], changes []*Change[int, T]) error {
+	// Applying the changes backwards keeps the positions of the pending ones valid.
+	for i := len(changes) - 1; i >= 0; i-- {
+		c := changes[i]
+		if c.key < 0 || c.key > l.Size() || (c.changeType != ChangeAdded && c.key == l.Size()) {
+			return ErrPatchConflict
+		}
+		switch c.changeType {
+		case ChangeAdded:
+			if !l.AddAt(c.key, c.value) {
+				return ErrPatchConflict
+			}
+		case ChangeRemoved:
+			l.RemoveAt(c.key)
+		case ChangeReplaced:
+			l.Set(c.key, c.value)
+		default:
+			return ErrPatchConflict
+		}
+	}
+	return nil
+}
+
+// PatchSet applies the changes returned by DiffSet to the set.
+func PatchSet[T comparable](s Set[T], changes []*Change[T, T]) {
+	for _, c := range changes {
+		switch c.changeType {
+		case ChangeAdded:
+			s.Add(c.key)
+		case ChangeRemoved:
+			s.Remove(c.key)
+		}
+	}
+}
+
+// PatchMap applies the changes returned by DiffMap to the map.
+func PatchMap[K comparable, T any](m Map[K, T], changes []*Change[K, T]) {
+	for _, c := range changes {
+		switch c.changeType {
+		case ChangeAdded, ChangeReplaced:
+			m.Set(c.key, c.value)
+		case ChangeRemoved:
+			m.Remove(c.key)
+		}
+	}
+}
+
+// myers returns the edits that turn a into b, without the kept elements, in order of their positions. It uses the
+// linear space refinement of the algorithm, which splits the sequences around the middle snake of a shortest path,
+// so the memory does not grow with the number of edits.
+func myers[T any](a, b []T, equal EqualFn[T]) []edit {
+	d := &differ[T]{a: a, b: b, equal: equal}
+	size := (len(a)+len(b)+1)/2 + 1
+	d.forward = make([]int, 2*size+1)
+	d.backward = make([]int, 2*size+1)
+	d.offset = size
+	d.diff(0, len(a), 0, len(b))
+	return d.edits
+}
+
+// diff appends the edits that turn a[aLow:aHigh] into b[bLow:bHigh].
+func (d *differ[T]) diff(aLow, aHigh, bLow, bHigh int) {
+	for aLow < aHigh && bLow < bHigh && d.equal(d.a[aLow], d.b[bLow]) {
+		aLow, bLow = aLow+1, bLow+1
+	}
+	for aLow < aHigh && bLow < bHigh && d.equal(d.a[aHigh-1], d.b[bHigh-1]) {
+		aHigh, bHigh = aHigh-1, bHigh-1
+	}
+
+	switch {
+	case aLow == aHigh:
+		for y := bLow; y < bHigh; y++ {
+			d.edits = append(d.edits, edit{changeType: ChangeAdded, x: aLow, y: y})
+		}
+	case bLow == bHigh:
+		for x := aLow; x < aHigh; x++ {
+			d.edits = append(d.edits, edit{changeType: ChangeRemoved, x: x, y: bLow})
+		}
+	default:
+		// Both ranges differ at their ends, so the snake splits them into two smaller problems.
+		x, y, u, v := d.middleSnake(aLow, aHigh, bLow, bHigh)
+		d.diff(aLow, x, bLow, y)
+		d.diff(u, aHigh, v, bHigh)
+	}
+}
+
+// middleSnake returns the start and the end of the diagonal in the middle of a shortest path from (aLow, bLow) to
+// (aHigh, bHigh), found by searching from both ends at once until the paths overlap.
+func (d *differ[T]) middleSnake(aLow, aHigh, bLow, bHigh int) (int, int, int, int) {
+	n, m := aHigh-aLow, bHigh-bLow
+	delta := n - m
+	odd := delta%2 != 0
+	// forward holds the furthest x reached on every diagonal k = x - y from the start, and backward the furthest
+	// distance reached from the end on every diagonal of the reversed sequences, which is delta - k.
+	forward, backward, offset := d.forward, d.backward, d.offset
+	forward[offset+1], backward[offset+1] = 0, 0
+
+	for depth := 0; depth <= (n+m+1)/2; depth++ {
+		for k := -depth; k <= depth; k += 2 {
+			var x int
+			if k == -depth || (k != depth && forward[offset+k-1] < forward[offset+k+1]) {
+				x = forward[offset+k+1]
+			} else {
+				x = forward[offset+k-1] + 1
+			}
+			y := x - k
+			startX, startY := x, y
+			for x < n && y < m && d.equal(d.a[aLow+x], d.b[bLow+y]) {
+				x, y = x+1, y+1
+			}
+			forward[offset+k] = x
+			if r := delta - k; odd && r >= -(depth-1) && r <= depth-1 && x+backward[offset+r] >= n {
+				return aLow + startX, bLow + startY, aLow + x, bLow + y
+			}
+		}
+
+		for r := -depth; r <= depth; r += 2 {
+			var x int
+			if r == -depth || (r != depth && backward[offset+r-1] < backward[offset+r+1]) {
+				x = backward[offset+r+1]
+			} else {
+				x = backward[offset+r-1] + 1
+			}
+			y := x - r
+			startX, startY := x, y
+			for x < n && y < m && d.equal(d.a[aHigh-1-x], d.b[bHigh-1-y]) {
+				x, y = x+1, y+1
+			}
+			backward[offset+r] = x
+			if k := delta - r; !odd && k >= -depth && k <= depth && x+forward[offset+k] >= n {
+				return aHigh - x, bHigh - y, aHigh - startX, bHigh - startY
+			}
+		}
+	}
+	panic("unreachable")
+}
+
+// coalesce turns the edits into changes, pairing the removals and additions of every run of consecutive edits into
+// replacements. The positions of the edits are shifted by the length of the common prefix.
+func coalesce[T any](edits []edit, a, b []T, prefix int) []*Change[int, T] {
+	var changes []*Change[int, T]
+	for i := 0; i < len(edits); {
+		// A run removes a[start:end] and adds elements before a[end], it ends when an element is kept.
+		start, end := edits[i].x, edits[i].x
+		var added []int
+		for ; i < len(edits) && edits[i].x == end; i++ {
+			if edits[i].changeType == ChangeRemoved {
+				end++
+			} else {
+				added = append(added, edits[i].y)
+			}
+		}
+
+		for j := 0; start+j < end || j < len(added); j++ {
+			x := prefix + start + j
+			switch {
+			case start+j < end && j < len(added):
+				changes = append(changes, &Change[int, T]{changeType: ChangeReplaced, key: x, value: b[prefix+added[j]], oldValue: a[x]})
+			case start+j < end:
+				changes = append(changes, &Change[int, T]{changeType: ChangeRemoved, key: x, value: a[x]})
+			default:
+				changes = append(changes, &Change[int, T]{changeType: ChangeAdded, key: prefix + end, value: b[prefix+added[j]]})
+			}
+		}
+	}
+	return changes
+}
@@ -0,0 +1,235 @@
+package collections
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestDiffList(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     string
+		expected []*Change[int, string]
+	}{
+		{
+			name:     "Equal",
+			a:        "abc",
+			b:        "abc",
+			expected: nil,
+		},
+		{
+			name: "Insert",
+			a:    "ac",
+			b:    "abc",
+			expected: []*Change[int, string]{
+				{changeType: ChangeAdded, key: 1, value: "b"},
+			},
+		},
+		{
+			name: "Delete",
+			a:    "abc",
+			b:    "ac",
+			expected: []*Change[int, string]{
+				{changeType: ChangeRemoved, key: 1, value: "b"},
+			},
+		},
+		{
+			name: "Replace",
+			a:    "abcd",
+			b:    "axyd",
+			expected: []*Change[int, string]{
+				{changeType: ChangeReplaced, key: 1, value: "x", oldValue: "b"},
+				{changeType: ChangeReplaced, key: 2, value: "y", oldValue: "c"},
+			},
+		},
+		{
+			name: "Append to empty",
+			a:    "",
+			b:    "ab",
+			expected: []*Change[int, string]{
+				{changeType: ChangeAdded, key: 0, value: "a"},
+				{changeType: ChangeAdded, key: 0, value: "b"},
+			},
+		},
+		{
+			name: "Mixed",
+			a:    "abcabba",
+			b:    "cbabac",
+			expected: []*Change[int, string]{
+				{changeType: ChangeReplaced, key: 0, value: "c", oldValue: "a"},
+				{changeType: ChangeRemoved, key: 2, value: "c"},
+				{changeType: ChangeRemoved, key: 5, value: "b"},
+				{changeType: ChangeAdded, key: 7, value: "c"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := NewArrayListWithElements(strings.Split(tt.a, ""))
+			if tt.a == "" {
+				a = NewArrayList[string]()
+			}
+			b := NewArrayListWithElements(strings.Split(tt.b, ""))
+
+			changes := DiffList[string](a, b)
+			assert.Equal(t, tt.expected, changes)
+
+			assert.NoError(t, PatchList[string](a, changes))
+			assert.Equal(t, b.ToArray(), a.ToArray())
+		})
+	}
+}
+
+func TestDiffList_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	random := func() []int {
+		elements := make([]int, r.Intn(30))
+		for i := range elements {
+			elements[i] = r.Intn(4)
+		}
+		return elements
+	}
+
+	for i := 0; i < 500; i++ {
+		x, y := random(), random()
+		a, b := NewArrayListWithElements(x), NewArrayListWithElements(y)
+
+		changes := DiffList[int](a, b)
+		edits := 0
+		for _, c := range changes {
+			if c.Type() == ChangeReplaced {
+				edits += 2
+			} else {
+				edits++
+			}
+		}
+		assert.Equal(t, len(x)+len(y)-2*lcsLength(x, y), edits)
+
+		assert.NoError(t, PatchList[int](a, changes))
+		assert.Equal(t, y, a.ToArray())
+	}
+}
+
+func TestDiffList_Large(t *testing.T) {
+	const n = 4000
+	x, y := make([]int, n), make([]int, n)
+	for i := range x {
+		x[i], y[i] = i, n+i
+	}
+	a, b := NewArrayListWithElements(x), NewArrayListWithElements(y)
+
+	// Disjoint lists are the worst case, keeping the state of every step would take gigabytes.
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	changes := DiffList[int](a, b)
+	runtime.ReadMemStats(&after)
+	assert.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(16<<20))
+
+	assert.Len(t, changes, n)
+	for i, c := range changes {
+		assert.Equal(t, &Change[int, int]{changeType: ChangeReplaced, key: i, value: n + i, oldValue: i}, c)
+	}
+	assert.NoError(t, PatchList[int](a, changes))
+	assert.Equal(t, y, a.ToArray())
+
+	// Scattered changes in long lists still produce a shortest script.
+	r := rand.New(rand.NewSource(2))
+	x, y = make([]int, 500), make([]int, 0, 500)
+	for i := range x {
+		x[i] = r.Intn(50)
+		if r.Intn(10) > 0 {
+			y = append(y, x[i])
+		}
+		if r.Intn(10) == 0 {
+			y = append(y, r.Intn(50))
+		}
+	}
+	a = NewArrayListWithElements(x)
+	changes = DiffList[int](a, NewArrayListWithElements(y))
+	edits := 0
+	for _, c := range changes {
+		if c.Type() == ChangeReplaced {
+			edits += 2
+		} else {
+			edits++
+		}
+	}
+	assert.Equal(t, len(x)+len(y)-2*lcsLength(x, y), edits)
+	assert.NoError(t, PatchList[int](a, changes))
+	assert.Equal(t, y, a.ToArray())
+}
+
+func lcsLength(a, b []int) int {
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			case lengths[i+1][j] > lengths[i][j+1]:
+				lengths[i][j] = lengths[i+1][j]
+			default:
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+	return lengths[0][0]
+}
+
+func TestDiffListFunc(t *testing.T) {
+	a := NewArrayListWithElements([]string{"Go", "Rust", "Zig"})
+	b := NewArrayListWithElements([]string{"go", "ZIG"})
+
+	changes := DiffListFunc[string](a, b, strings.EqualFold)
+	assert.Equal(t, []*Change[int, string]{
+		{changeType: ChangeRemoved, key: 1, value: "Rust"},
+	}, changes)
+}
+
+func TestPatchList_Conflict(t *testing.T) {
+	l := NewArrayListWithElements([]int{1, 2})
+
+	assert.ErrorIs(t, PatchList[int](l, []*Change[int, int]{{changeType: ChangeRemoved, key: 2}}), ErrPatchConflict)
+	assert.ErrorIs(t, PatchList[int](l, []*Change[int, int]{{changeType: ChangeAdded, key: 3}}), ErrPatchConflict)
+	assert.ErrorIs(t, PatchList[int](NewSortedListWithElements(func(a, b int) int { return a - b }, []int{1, 2}),
+		[]*Change[int, int]{{changeType: ChangeAdded, key: 0, value: 5}}), ErrPatchConflict)
+	assert.Equal(t, []int{1, 2}, l.ToArray())
+}
+
+func TestDiffSet(t *testing.T) {
+	a := NewValueSetWithElements([]string{"read", "write"})
+	b := NewValueSetWithElements([]string{"read", "admin"})
+
+	changes := DiffSet[string](a, b)
+	assert.ElementsMatch(t, []*Change[string, string]{
+		{changeType: ChangeRemoved, key: "write", value: "write"},
+		{changeType: ChangeAdded, key: "admin", value: "admin"},
+	}, changes)
+
+	PatchSet[string](a, changes)
+	assert.ElementsMatch(t, b.ToArray(), a.ToArray())
+}
+
+func TestDiffMap(t *testing.T) {
+	a := Dictionary[string, int]{"a": 1, "b": 2, "c": 3}
+	b := Dictionary[string, int]{"a": 1, "b": 5, "d": 4}
+	equal := func(x, y int) bool { return x == y }
+
+	changes := DiffMap[string, int](a, b, equal)
+	assert.ElementsMatch(t, []*Change[string, int]{
+		{changeType: ChangeReplaced, key: "b", value: 5, oldValue: 2},
+		{changeType: ChangeRemoved, key: "c", value: 3},
+		{changeType: ChangeAdded, key: "d", value: 4},
+	}, changes)
+
+	PatchMap[string, int](a, changes)
+	assert.Equal(t, b, a)
+	assert.Empty(t, DiffMap[string, int](a, b, equal))
+}
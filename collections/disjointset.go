@@ -0,0 +1,119 @@
+package collections
+
+type (
+
+	// DisjointSet is a union-find structure that partitions its elements into disjoint sets, it uses path compression
+	// and union by rank so its operations run in nearly constant amortized time.
+	DisjointSet[T comparable] struct {
+		parents Dictionary[T, T]
+		ranks   Dictionary[T, int]
+		sets    int
+	}
+)
+
+// NewDisjointSet returns a new empty DisjointSet.
+func NewDisjointSet[T comparable]() *DisjointSet[T] {
+	return &DisjointSet[T]{
+		parents: Dictionary[T, T]{},
+		ranks:   Dictionary[T, int]{},
+	}
+}
+
+// NewDisjointSetWithElements returns a new DisjointSet with every specified element in its own set.
+func NewDisjointSetWithElements[T comparable](elements []T) *DisjointSet[T] {
+	s := NewDisjointSet[T]()
+	for _, t := range elements {
+		s.Add(t)
+	}
+	return s
+}
+
+// Add adds the element in its own set, it returns false if the element already exists.
+func (s *DisjointSet[T]) Add(t T) bool {
+	if s.parents.Has(t) {
+		return false
+	}
+	s.parents.Set(t, t)
+	s.sets++
+	return true
+}
+
+// Contains returns true if the element exists.
+func (s *DisjointSet[T]) Contains(t T) bool {
+	return s.parents.Has(t)
+}
+
+// Find returns the representative element of the set containing the element, an element that does not exist is its
+// own representative.
+func (s *DisjointSet[T]) Find(t T) T {
+	root := t
+	for {
+		parent, ok := s.parents[root]
+		if !ok || parent == root {
+			break
+		}
+		root = parent
+	}
+
+	// Point every element of the path straight to the root.
+	for t != root {
+		next := s.parents[t]
+		s.parents[t] = root
+		t = next
+	}
+	return root
+}
+
+// Union merges the sets containing the elements, adding the elements that do not exist. It returns false if the
+// elements were already in the same set.
+func (s *DisjointSet[T]) Union(a, b T) bool {
+	s.Add(a)
+	s.Add(b)
+
+	rootA, rootB := s.Find(a), s.Find(b)
+	if rootA == rootB {
+		return false
+	}
+
+	rankA, rankB := s.ranks[rootA], s.ranks[rootB]
+	if rankA < rankB {
+		rootA, rootB = rootB, rootA
+	}
+	s.parents[rootB] = rootA
+	if rankA == rankB {
+		s.ranks[rootA]++
+	}
+	s.ranks.Remove(rootB)
+	s.sets--
+	return true
+}
+
+// Connected returns true if both elements are in the same set.
+func (s *DisjointSet[T]) Connected(a, b T) bool {
+	return s.Find(a) == s.Find(b)
+}
+
+// SetCount returns the number of disjoint sets.
+func (s *DisjointSet[T]) SetCount() int {
+	return s.sets
+}
+
+// Size returns the number of elements.
+func (s *DisjointSet[T]) Size() int {
+	return s.parents.Size()
+}
+
+// Groups returns the elements of every set keyed by the representative element of the set.
+func (s *DisjointSet[T]) Groups() Dictionary[T, *ArrayList[T]] {
+	groups := make(Dictionary[T, *ArrayList[T]], s.sets)
+	for t := range s.parents {
+		root := s.Find(t)
+		group, ok := groups[root]
+		if !ok {
+			group = NewArrayList[T]()
+			groups[root] = group
+		}
+		group.Add(t)
+	}
+	return groups
+}
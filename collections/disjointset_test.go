@@ -0,0 +1,90 @@
+package collections
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestDisjointSet_Union(t *testing.T) {
+	s := NewDisjointSetWithElements([]string{"a", "b", "c", "d", "e"})
+
+	assert.True(t, s.Union("a", "b"))
+	assert.True(t, s.Union("c", "d"))
+	assert.True(t, s.Union("b", "d"))
+	assert.False(t, s.Union("a", "c"))
+	assert.True(t, s.Union("f", "g"))
+
+	assert.Equal(t, 7, s.Size())
+	assert.Equal(t, 3, s.SetCount())
+	assert.True(t, s.Connected("a", "d"))
+	assert.False(t, s.Connected("a", "e"))
+	assert.True(t, s.Connected("f", "g"))
+	assert.Equal(t, s.Find("a"), s.Find("c"))
+}
+
+func TestDisjointSet_Find(t *testing.T) {
+	s := NewDisjointSet[int]()
+
+	assert.Equal(t, 1, s.Find(1))
+	assert.False(t, s.Contains(1))
+	assert.True(t, s.Add(1))
+	assert.False(t, s.Add(1))
+	assert.Equal(t, 1, s.Find(1))
+	assert.False(t, s.Connected(1, 2))
+	assert.True(t, s.Connected(3, 3))
+}
+
+func TestDisjointSet_Groups(t *testing.T) {
+	s := NewDisjointSetWithElements([]int{1, 2, 3, 4, 5, 6})
+	s.Union(1, 3)
+	s.Union(3, 5)
+	s.Union(2, 4)
+
+	groups := s.Groups()
+	assert.Equal(t, 3, groups.Size())
+
+	var actual [][]int
+	for root, group := range groups {
+		elements := group.ToArray()
+		sort.Ints(elements)
+		assert.Contains(t, elements, root)
+		actual = append(actual, elements)
+	}
+	sort.Slice(actual, func(i, j int) bool {
+		return actual[i][0] < actual[j][0]
+	})
+	assert.Equal(t, [][]int{{1, 3, 5}, {2, 4}, {6}}, actual)
+}
+
+func TestDisjointSet_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	s := NewDisjointSet[int]()
+	labels := make([]int, 500)
+	for i := range labels {
+		labels[i] = i
+		s.Add(i)
+	}
+
+	for i := 0; i < 300; i++ {
+		a, b := r.Intn(len(labels)), r.Intn(len(labels))
+		s.Union(a, b)
+		from, to := labels[b], labels[a]
+		for j := range labels {
+			if labels[j] == from {
+				labels[j] = to
+			}
+		}
+	}
+
+	sets := map[int]bool{}
+	for _, label := range labels {
+		sets[label] = true
+	}
+	assert.Equal(t, len(sets), s.SetCount())
+	for i := 0; i < 1000; i++ {
+		a, b := r.Intn(len(labels)), r.Intn(len(labels))
+		assert.Equal(t, labels[a] == labels[b], s.Connected(a, b))
+	}
+}
@@ -0,0 +1,265 @@
+package collections
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+// The marshal methods use value receivers so collections embedded by value in other structs are encoded as well.
+// The collections do not implement encoding.TextMarshaler, so encoders with native list and map support, such as YAML
+// or TOML, keep encoding them as lists and maps instead of strings holding JSON.
+
+var (
+	_ json.Marshaler             = ArrayList[any]{}
+	_ json.Unmarshaler           = (*ArrayList[any])(nil)
+	_ encoding.BinaryMarshaler   = ArrayList[any]{}
+	_ encoding.BinaryUnmarshaler = (*ArrayList[any])(nil)
+
+	_ json.Marshaler             = ValueSet[string]{}
+	_ json.Unmarshaler           = (*ValueSet[string])(nil)
+	_ encoding.BinaryMarshaler   = ValueSet[string]{}
+	_ encoding.BinaryUnmarshaler = (*ValueSet[string])(nil)
+
+	_ json.Marshaler             = Dictionary[string, any]{}
+	_ json.Unmarshaler           = (*Dictionary[string, any])(nil)
+	_ encoding.BinaryMarshaler   = Dictionary[string, any]{}
+	_ encoding.BinaryUnmarshaler = (*Dictionary[string, any])(nil)
+)
+
+type (
+	// encodedEntry is an entry of a Dictionary as it is encoded in a JSON array or with encoding/gob.
+	encodedEntry[K comparable, T any] struct {
+		Key   K `json:"key"`
+		Value T `json:"value"`
+	}
+)
+
+// MarshalJSON encodes the list as a JSON array.
+func (a ArrayList[T]) MarshalJSON() ([]byte, error) {
+	if a.elements == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(a.elements)
+}
+
+// UnmarshalJSON replaces the elements of the list with the elements of a JSON array.
+func (a *ArrayList[T]) UnmarshalJSON(data []byte) error {
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	a.elements = make([]T, 0, len(elements))
+	a.elements = append(a.elements, elements...)
+	return nil
+}
+
+// MarshalBinary encodes the list with encoding/gob, which also uses it to encode the list.
+func (a ArrayList[T]) MarshalBinary() ([]byte, error) {
+	return gobEncode(a.elements)
+}
+
+// UnmarshalBinary replaces the elements of the list with the elements encoded by MarshalBinary.
+func (a *ArrayList[T]) UnmarshalBinary(data []byte) error {
+	var elements []T
+	if err := gobDecode(data, &elements); err != nil {
+		return err
+	}
+	a.elements = make([]T, 0, len(elements))
+	a.elements = append(a.elements, elements...)
+	return nil
+}
+
+// MarshalJSON encodes the set as a JSON array, sorted when the elements are numbers or strings.
+func (h ValueSet[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(h.sortedArray())
+}
+
+// UnmarshalJSON replaces the elements of the set with the elements of a JSON array.
+func (h *ValueSet[T]) UnmarshalJSON(data []byte) error {
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	h.elements = NewValueSetWithElements(elements).elements
+	return nil
+}
+
+// MarshalBinary encodes the set with encoding/gob, which also uses it to encode the set.
+func (h ValueSet[T]) MarshalBinary() ([]byte, error) {
+	return gobEncode(h.sortedArray())
+}
+
+// UnmarshalBinary replaces the elements of the set with the elements encoded by MarshalBinary.
+func (h *ValueSet[T]) UnmarshalBinary(data []byte) error {
+	var elements []T
+	if err := gobDecode(data, &elements); err != nil {
+		return err
+	}
+	h.elements = NewValueSetWithElements(elements).elements
+	return nil
+}
+
+func (h ValueSet[T]) sortedArray() []T {
+	elements := h.ToArray()
+	sortOrdered(elements)
+	return elements
+}
+
+// MarshalJSON encodes the dictionary as a JSON object with its keys sorted when the keys are strings, integers or
+// implement encoding.TextMarshaler. Other keys are encoded as an array of {"key", "value"} objects sorted by key.
+func (d Dictionary[K, T]) MarshalJSON() ([]byte, error) {
+	if d == nil {
+		return []byte("{}"), nil
+	}
+	if isJSONKey[K]() {
+		// encoding/json sorts the keys of the maps it encodes.
+		return json.Marshal(map[K]T(d))
+	}
+
+	entries, err := d.entriesByJSONKey()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(entries)
+}
+
+// UnmarshalJSON replaces the entries of the dictionary with the entries of a JSON object, or of an array of
+// {"key", "value"} objects.
+func (d *Dictionary[K, T]) UnmarshalJSON(data []byte) error {
+	result := make(Dictionary[K, T])
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		var entries []encodedEntry[K, T]
+		if err := json.Unmarshal(trimmed, &entries); err != nil {
+			return err
+		}
+		for _, e := range entries {
+			result[e.Key] = e.Value
+		}
+	} else if err := json.Unmarshal(data, (*map[K]T)(&result)); err != nil {
+		return err
+	}
+	*d = result
+	return nil
+}
+
+// MarshalBinary encodes the dictionary with encoding/gob, which also uses it to encode the dictionary. The entries
+// are sorted by key so equal dictionaries have the same encoding: numbers and strings by value and other keys by
+// their JSON encoding.
+func (d Dictionary[K, T]) MarshalBinary() ([]byte, error) {
+	if !isOrdered[K]() {
+		entries, err := d.entriesByJSONKey()
+		if err != nil {
+			return nil, err
+		}
+		return gobEncode(entries)
+	}
+
+	keys := d.Keys()
+	sortOrdered(keys)
+	entries := make([]encodedEntry[K, T], len(keys))
+	for i, k := range keys {
+		entries[i] = encodedEntry[K, T]{Key: k, Value: d[k]}
+	}
+	return gobEncode(entries)
+}
+
+// UnmarshalBinary replaces the entries of the dictionary with the entries encoded by MarshalBinary.
+func (d *Dictionary[K, T]) UnmarshalBinary(data []byte) error {
+	var entries []encodedEntry[K, T]
+	if err := gobDecode(data, &entries); err != nil {
+		return err
+	}
+	result := make(Dictionary[K, T], len(entries))
+	for _, e := range entries {
+		result[e.Key] = e.Value
+	}
+	*d = result
+	return nil
+}
+
+// entriesByJSONKey returns the entries of the dictionary sorted by the JSON encoding of their keys.
+func (d Dictionary[K, T]) entriesByJSONKey() ([]encodedEntry[K, T], error) {
+	type encoded struct {
+		key   []byte
+		entry encodedEntry[K, T]
+	}
+	entries := make([]encoded, 0, len(d))
+	for k, v := range d {
+		key, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, encoded{key: key, entry: encodedEntry[K, T]{Key: k, Value: v}})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].key, entries[j].key) < 0
+	})
+
+	result := make([]encodedEntry[K, T], len(entries))
+	for i, e := range entries {
+		result[i] = e.entry
+	}
+	return result, nil
+}
+
+// isJSONKey returns true if encoding/json can encode K as the key of a JSON object.
+func isJSONKey[K comparable]() bool {
+	t := reflect.TypeOf((*K)(nil)).Elem()
+	if t.Implements(reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
+
+// isOrdered returns true if sortOrdered sorts elements of type T.
+func isOrdered[T any]() bool {
+	switch reflect.TypeOf((*T)(nil)).Elem().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.String:
+		return true
+	}
+	return false
+}
+
+// sortOrdered sorts the elements if their type is a number or a string, otherwise it leaves them untouched.
+func sortOrdered[T any](elements []T) {
+	var less func(a, b reflect.Value) bool
+	switch reflect.TypeOf((*T)(nil)).Elem().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		less = func(a, b reflect.Value) bool { return a.Int() < b.Int() }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		less = func(a, b reflect.Value) bool { return a.Uint() < b.Uint() }
+	case reflect.Float32, reflect.Float64:
+		less = func(a, b reflect.Value) bool { return a.Float() < b.Float() }
+	case reflect.String:
+		less = func(a, b reflect.Value) bool { return a.String() < b.String() }
+	default:
+		return
+	}
+	sort.Slice(elements, func(i, j int) bool {
+		return less(reflect.ValueOf(elements[i]), reflect.ValueOf(elements[j]))
+	})
+}
+
+func gobEncode(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func gobDecode(data []byte, v any) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
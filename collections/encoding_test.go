@@ -0,0 +1,178 @@
+package collections
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+type (
+	dto struct {
+		Tags   ArrayList[string]       `json:"tags"`
+		IDs    *ValueSet[int]          `json:"ids"`
+		Labels Dictionary[string, int] `json:"labels"`
+	}
+
+	coordinate struct {
+		X int `json:"x"`
+		Y int `json:"y"`
+	}
+)
+
+func TestArrayList_JSON(t *testing.T) {
+	list := NewArrayListWithElements([]int{3, 1, 2})
+
+	data, err := json.Marshal(list)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[3, 1, 2]`, string(data))
+
+	empty, err := json.Marshal(NewArrayList[int]())
+	assert.NoError(t, err)
+	assert.Equal(t, `[]`, string(empty))
+
+	actual := NewArrayList[int]()
+	assert.NoError(t, json.Unmarshal(data, actual))
+	assert.Equal(t, []int{3, 1, 2}, actual.ToArray())
+}
+
+func TestValueSet_JSON(t *testing.T) {
+	set := NewValueSetWithElements([]string{"c", "a", "b"})
+
+	data, err := json.Marshal(set)
+	assert.NoError(t, err)
+	assert.Equal(t, `["a","b","c"]`, string(data))
+
+	var actual ValueSet[string]
+	assert.NoError(t, json.Unmarshal([]byte(`["x","y","x"]`), &actual))
+	assert.Equal(t, 2, actual.Size())
+	assert.True(t, actual.Contains("y"))
+}
+
+func TestDictionary_JSON(t *testing.T) {
+	d := Dictionary[string, int]{"b": 2, "a": 1, "c": 3}
+
+	data, err := json.Marshal(d)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":1,"b":2,"c":3}`, string(data))
+
+	var actual Dictionary[string, int]
+	assert.NoError(t, json.Unmarshal(data, &actual))
+	assert.Equal(t, d, actual)
+}
+
+func TestDictionary_JSON_StructKeys(t *testing.T) {
+	d := Dictionary[coordinate, string]{{2, 1}: "b", {1, 2}: "a"}
+
+	data, err := json.Marshal(d)
+	assert.NoError(t, err)
+	assert.Equal(t, `[{"key":{"x":1,"y":2},"value":"a"},{"key":{"x":2,"y":1},"value":"b"}]`, string(data))
+
+	var actual Dictionary[coordinate, string]
+	assert.NoError(t, json.Unmarshal(data, &actual))
+	assert.Equal(t, d, actual)
+}
+
+func TestCollections_JSON_DTO(t *testing.T) {
+	value := dto{
+		Tags:   *NewArrayListWithElements([]string{"x", "y"}),
+		IDs:    NewValueSetWithElements([]int{3, 1, 2}),
+		Labels: Dictionary[string, int]{"a": 1},
+	}
+
+	data, err := json.Marshal(value)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"tags":["x","y"],"ids":[1,2,3],"labels":{"a":1}}`, string(data))
+
+	var actual dto
+	assert.NoError(t, json.Unmarshal(data, &actual))
+	assert.Equal(t, []string{"x", "y"}, actual.Tags.ToArray())
+	assert.Equal(t, 3, actual.IDs.Size())
+	assert.Equal(t, Dictionary[string, int]{"a": 1}, actual.Labels)
+}
+
+func TestCollections_NotText(t *testing.T) {
+	// Encoders with native list and map support must not encode the collections as strings.
+	for _, c := range []any{NewArrayList[int](), NewValueSet[int](), Dictionary[string, int]{}} {
+		_, ok := c.(encoding.TextMarshaler)
+		assert.False(t, ok)
+		_, ok = c.(encoding.TextUnmarshaler)
+		assert.False(t, ok)
+	}
+}
+
+func TestCollections_Binary(t *testing.T) {
+	list := NewArrayListWithElements([]string{"a", "b"})
+	data, err := list.MarshalBinary()
+	assert.NoError(t, err)
+	actualList := NewArrayList[string]()
+	assert.NoError(t, actualList.UnmarshalBinary(data))
+	assert.Equal(t, list.ToArray(), actualList.ToArray())
+
+	set := NewValueSetWithElements([]int{1, 2})
+	data, err = set.MarshalBinary()
+	assert.NoError(t, err)
+	actualSet := NewValueSet[int]()
+	assert.NoError(t, actualSet.UnmarshalBinary(data))
+	assert.Equal(t, []int{1, 2}, actualSet.sortedArray())
+
+	d := Dictionary[string, int]{"a": 1}
+	data, err = d.MarshalBinary()
+	assert.NoError(t, err)
+	var actualDictionary Dictionary[string, int]
+	assert.NoError(t, actualDictionary.UnmarshalBinary(data))
+	assert.Equal(t, d, actualDictionary)
+}
+
+func TestDictionary_MarshalBinary_Deterministic(t *testing.T) {
+	type cell struct {
+		Row, Column int
+	}
+	names, cells := Dictionary[string, int]{}, Dictionary[cell, string]{}
+	for i := 0; i < 100; i++ {
+		names[strconv.Itoa(i)] = i
+		cells[cell{i % 10, i / 10}] = strconv.Itoa(i)
+	}
+
+	for _, d := range []encoding.BinaryMarshaler{names, cells} {
+		expected, err := d.MarshalBinary()
+		assert.NoError(t, err)
+		for i := 0; i < 10; i++ {
+			data, err := d.MarshalBinary()
+			assert.NoError(t, err)
+			assert.Equal(t, expected, data)
+		}
+	}
+
+	data, err := cells.MarshalBinary()
+	assert.NoError(t, err)
+	var actual Dictionary[cell, string]
+	assert.NoError(t, actual.UnmarshalBinary(data))
+	assert.Equal(t, cells, actual)
+
+	data, err = Dictionary[string, int](nil).MarshalBinary()
+	assert.NoError(t, err)
+	var empty Dictionary[string, int]
+	assert.NoError(t, empty.UnmarshalBinary(data))
+	assert.Equal(t, Dictionary[string, int]{}, empty)
+}
+
+func TestCollections_Gob(t *testing.T) {
+	value := dto{
+		Tags:   *NewArrayListWithElements([]string{"x", "y"}),
+		IDs:    NewValueSetWithElements([]int{1, 2}),
+		Labels: Dictionary[string, int]{"a": 1},
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(value))
+
+	var actual dto
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(&actual))
+	assert.Equal(t, []string{"x", "y"}, actual.Tags.ToArray())
+	assert.True(t, actual.IDs.Contains(2))
+	assert.Equal(t, Dictionary[string, int]{"a": 1}, actual.Labels)
+}
@@ -0,0 +1,125 @@
+package collections
+
+type (
+
+	// FenwickTree is a binary indexed tree over a fixed number of numeric elements that updates ranges of elements
+	// and sums them in logarithmic time. It keeps two trees so that adding to a range is as cheap as adding to a
+	// single element. The ranges are half-open [from, to).
+	FenwickTree[T Number] struct {
+		size int
+		// sums and corrections are 1-based, the sum of the first i elements is sums(i) * i - corrections(i).
+		sums        []T
+		corrections []T
+	}
+)
+
+// NewFenwickTree returns a new FenwickTree with the specified number of zero elements.
+func NewFenwickTree[T Number](size int) *FenwickTree[T] {
+	return &FenwickTree[T]{
+		size:        size,
+		sums:        make([]T, size+1),
+		corrections: make([]T, size+1),
+	}
+}
+
+// NewFenwickTreeWithElements returns a new FenwickTree with the specified elements.
+func NewFenwickTreeWithElements[T Number](elements []T) *FenwickTree[T] {
+	f := NewFenwickTree[T](len(elements))
+	for i, e := range elements {
+		f.AddRange(i, i+1, e)
+	}
+	return f
+}
+
+// Size returns the number of elements in the tree.
+func (f *FenwickTree[T]) Size() int {
+	return f.size
+}
+
+// Get returns the element at the specified position, it panics with ErrIndexOutOfBounds if the position is out of
+// range.
+func (f *FenwickTree[T]) Get(i int) T {
+	f.checkIndex(i)
+	return f.RangeSum(i, i+1)
+}
+
+// Set replaces the element at the specified position, it panics with ErrIndexOutOfBounds if the position is out of
+// range.
+func (f *FenwickTree[T]) Set(i int, t T) {
+	f.Add(i, t-f.Get(i))
+}
+
+// Add adds the delta to the element at the specified position, it panics with ErrIndexOutOfBounds if the position is
+// out of range.
+func (f *FenwickTree[T]) Add(i int, delta T) {
+	f.checkIndex(i)
+	f.AddRange(i, i+1, delta)
+}
+
+// AddRange adds the delta to every element in [from, to), it panics with ErrIndexOutOfBounds if the range is out of
+// bounds.
+func (f *FenwickTree[T]) AddRange(from, to int, delta T) {
+	f.checkRange(from, to)
+	if from == to {
+		return
+	}
+	f.add(f.sums, from+1, delta)
+	f.add(f.sums, to+1, -delta)
+	f.add(f.corrections, from+1, delta*T(from))
+	f.add(f.corrections, to+1, -delta*T(to))
+}
+
+// PrefixSum returns the sum of the first n elements, it panics with ErrIndexOutOfBounds if n is out of range.
+func (f *FenwickTree[T]) PrefixSum(n int) T {
+	f.checkRange(0, n)
+	return f.prefixSum(n)
+}
+
+// RangeSum returns the sum of the elements in [from, to), it panics with ErrIndexOutOfBounds if the range is out of
+// bounds.
+func (f *FenwickTree[T]) RangeSum(from, to int) T {
+	f.checkRange(from, to)
+	return f.prefixSum(to) - f.prefixSum(from)
+}
+
+// ToArray returns an array containing all the elements in the tree.
+func (f *FenwickTree[T]) ToArray() []T {
+	array := make([]T, f.size)
+	var previous T
+	for i := range array {
+		sum := f.prefixSum(i + 1)
+		array[i] = sum - previous
+		previous = sum
+	}
+	return array
+}
+
+func (f *FenwickTree[T]) add(tree []T, i int, delta T) {
+	for ; i <= f.size; i += i & -i {
+		tree[i] += delta
+	}
+}
+
+func (f *FenwickTree[T]) sum(tree []T, i int) T {
+	var sum T
+	for ; i > 0; i -= i & -i {
+		sum += tree[i]
+	}
+	return sum
+}
+
+func (f *FenwickTree[T]) prefixSum(n int) T {
+	return f.sum(f.sums, n)*T(n) - f.sum(f.corrections, n)
+}
+
+func (f *FenwickTree[T]) checkIndex(i int) {
+	if i < 0 || i >= f.size {
+		panic(ErrIndexOutOfBounds)
+	}
+}
+
+func (f *FenwickTree[T]) checkRange(from, to int) {
+	if from < 0 || to > f.size || from > to {
+		panic(ErrIndexOutOfBounds)
+	}
+}
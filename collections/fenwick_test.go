@@ -0,0 +1,82 @@
+package collections
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+)
+
+func TestFenwickTree_RangeSum(t *testing.T) {
+	f := NewFenwickTreeWithElements([]int{3, 1, 4, 1, 5, 9, 2, 6})
+
+	tests := []struct {
+		name     string
+		from, to int
+		expected int
+	}{
+		{name: "All", from: 0, to: 8, expected: 31},
+		{name: "Prefix", from: 0, to: 3, expected: 8},
+		{name: "Middle", from: 2, to: 6, expected: 19},
+		{name: "Single", from: 7, to: 8, expected: 6},
+		{name: "Empty", from: 4, to: 4, expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, f.RangeSum(tt.from, tt.to))
+		})
+	}
+}
+
+func TestFenwickTree_Update(t *testing.T) {
+	f := NewFenwickTree[float64](5)
+
+	f.Add(1, 2.5)
+	f.Set(3, 4)
+	f.AddRange(1, 4, 1)
+	f.Set(2, 0)
+
+	assert.Equal(t, []float64{0, 3.5, 0, 5, 0}, f.ToArray())
+	assert.Equal(t, 3.5, f.Get(1))
+	assert.Equal(t, 8.5, f.PrefixSum(5))
+	assert.Equal(t, 5, f.Size())
+}
+
+func TestFenwickTree_OutOfBounds(t *testing.T) {
+	f := NewFenwickTree[uint](3)
+
+	assert.PanicsWithValue(t, ErrIndexOutOfBounds, func() { f.Get(3) })
+	assert.PanicsWithValue(t, ErrIndexOutOfBounds, func() { f.RangeSum(2, 1) })
+	assert.PanicsWithValue(t, ErrIndexOutOfBounds, func() { f.AddRange(0, 4, 1) })
+	assert.PanicsWithValue(t, ErrIndexOutOfBounds, func() { f.PrefixSum(-1) })
+}
+
+func TestFenwickTree_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	expected := make([]int64, 100)
+	f := NewFenwickTree[int64](len(expected))
+
+	for i := 0; i < 2000; i++ {
+		from := r.Intn(len(expected))
+		to := from + r.Intn(len(expected)-from+1)
+		delta := int64(r.Intn(200) - 100)
+
+		switch r.Intn(3) {
+		case 0:
+			f.AddRange(from, to, delta)
+			for j := from; j < to; j++ {
+				expected[j] += delta
+			}
+		case 1:
+			f.Set(from, delta)
+			expected[from] = delta
+		default:
+			var sum int64
+			for j := from; j < to; j++ {
+				sum += expected[j]
+			}
+			assert.Equal(t, sum, f.RangeSum(from, to))
+		}
+	}
+	assert.Equal(t, expected, f.ToArray())
+}
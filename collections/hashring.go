@@ -0,0 +1,282 @@
+package collections
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"sort"
+	"strconv"
+)
+
+type (
+
+	// HashRing is a consistent hash ring that maps keys to nodes. Every node is placed on the ring as many virtual
+	// nodes as its weight times the virtual nodes per weight, and adding or removing a node only moves the keys
+	// between that node and its neighbors on the ring. The nodes are placed by their name, so the positions are
+	// stable across processes.
+	HashRing[N comparable] struct {
+		points    []ringPoint[N]
+		name      func(N) string
+		names     Dictionary[N, string]
+		weights   Dictionary[N, int]
+		loads     Dictionary[N, int]
+		totalLoad int
+		options   ringOptions
+	}
+
+	// RingOption configures a HashRing.
+	RingOption func(*ringOptions)
+
+	ringOptions struct {
+		virtualNodes int
+		loadFactor   float64
+		hasher       func(string) uint64
+	}
+
+	ringPoint[N comparable] struct {
+		hash uint64
+		node N
+	}
+)
+
+const (
+	defaultVirtualNodes = 100
+	defaultLoadFactor   = 1.25
+)
+
+var (
+	ErrEmptyRing           = errors.New("hash ring has no nodes")
+	ErrInvalidWeight       = errors.New("node weight must be positive")
+	ErrInvalidLoadFactor   = errors.New("load factor must be greater than 1")
+	ErrInvalidVirtualNodes = errors.New("virtual nodes must be positive")
+)
+
+// WithVirtualNodes sets the number of virtual nodes placed on the ring per unit of weight, 100 by default. More
+// virtual nodes spread the keys more evenly at the cost of memory. It panics with ErrInvalidVirtualNodes if n is not
+// positive.
+func WithVirtualNodes(n int) RingOption {
+	if n <= 0 {
+		panic(ErrInvalidVirtualNodes)
+	}
+	return func(o *ringOptions) {
+		o.virtualNodes = n
+	}
+}
+
+// WithLoadFactor sets how much the load of a node can exceed the average load when keys are acquired, 1.25 by
+// default. It panics with ErrInvalidLoadFactor if the factor is not greater than 1.
+func WithLoadFactor(factor float64) RingOption {
+	if factor <= 1 {
+		panic(ErrInvalidLoadFactor)
+	}
+	return func(o *ringOptions) {
+		o.loadFactor = factor
+	}
+}
+
+// WithRingHasher sets the function that hashes keys and virtual nodes, FNV-1a by default.
+func WithRingHasher(hasher func(string) uint64) RingOption {
+	return func(o *ringOptions) {
+		o.hasher = hasher
+	}
+}
+
+// NewHashRing returns a new empty HashRing whose nodes are named by their String method if they have one, and by
+// their default format otherwise.
+func NewHashRing[N comparable](opts ...RingOption) *HashRing[N] {
+	return NewHashRingFunc(nodeName[N], opts...)
+}
+
+// NewHashRingFunc returns a new empty HashRing whose nodes are named by the function, which must return distinct
+// names for distinct nodes.
+func NewHashRingFunc[N comparable](name func(N) string, opts ...RingOption) *HashRing[N] {
+	options := ringOptions{
+		virtualNodes: defaultVirtualNodes,
+		loadFactor:   defaultLoadFactor,
+		hasher:       ringHash,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return &HashRing[N]{
+		name:    name,
+		names:   Dictionary[N, string]{},
+		weights: Dictionary[N, int]{},
+		loads:   Dictionary[N, int]{},
+		options: options,
+	}
+}
+
+// Add adds the node with a weight of 1, it returns false if the node already exists.
+func (r *HashRing[N]) Add(node N) bool {
+	return r.AddWithWeight(node, 1)
+}
+
+// AddWithWeight adds the node with the weight, a node receives a share of the keys proportional to its weight. It
+// returns false if the node already exists and panics with ErrInvalidWeight if the weight is not positive.
+func (r *HashRing[N]) AddWithWeight(node N, weight int) bool {
+	if weight <= 0 {
+		panic(ErrInvalidWeight)
+	}
+	if !r.weights.PutIfAbsent(node, weight) {
+		return false
+	}
+
+	name := r.name(node)
+	r.names[node] = name
+	for i := 0; i < weight*r.options.virtualNodes; i++ {
+		r.points = append(r.points, ringPoint[N]{hash: r.options.hasher(name + "#" + strconv.Itoa(i)), node: node})
+	}
+	sort.Slice(r.points, func(i, j int) bool {
+		if r.points[i].hash != r.points[j].hash {
+			return r.points[i].hash < r.points[j].hash
+		}
+		// Break the ties so that the ring does not depend on the order the nodes were added.
+		return r.names[r.points[i].node] < r.names[r.points[j].node]
+	})
+	return true
+}
+
+// Remove removes the node, it returns false if the node does not exist.
+func (r *HashRing[N]) Remove(node N) bool {
+	if !r.weights.Has(node) {
+		return false
+	}
+	r.weights.Remove(node)
+	r.names.Remove(node)
+	r.totalLoad -= r.loads[node]
+	r.loads.Remove(node)
+
+	points := r.points[:0]
+	for _, p := range r.points {
+		if p.node != node {
+			points = append(points, p)
+		}
+	}
+	r.points = points
+	return true
+}
+
+// Has returns true if the node exists.
+func (r *HashRing[N]) Has(node N) bool {
+	return r.weights.Has(node)
+}
+
+// Nodes returns the nodes of the ring.
+func (r *HashRing[N]) Nodes() []N {
+	return r.weights.Keys()
+}
+
+// Size returns the number of nodes in the ring.
+func (r *HashRing[N]) Size() int {
+	return r.weights.Size()
+}
+
+// IsEmpty returns true if the ring has no nodes.
+func (r *HashRing[N]) IsEmpty() bool {
+	return r.weights.Size() == 0
+}
+
+// Get returns the node that owns the key, or ErrEmptyRing if the ring has no nodes.
+func (r *HashRing[N]) Get(key string) (N, error) {
+	if len(r.points) == 0 {
+		var zero N
+		return zero, ErrEmptyRing
+	}
+	return r.points[r.search(key)].node, nil
+}
+
+// GetN returns up to n distinct nodes for the key in ring order, the first one is the owner of the key and the
+// others are the replicas. It returns ErrEmptyRing if the ring has no nodes.
+func (r *HashRing[N]) GetN(key string, n int) ([]N, error) {
+	if len(r.points) == 0 {
+		return nil, ErrEmptyRing
+	}
+	if n > r.Size() {
+		n = r.Size()
+	}
+
+	nodes := make([]N, 0, n)
+	seen := NewValueSet[N]()
+	for i, start := 0, r.search(key); len(nodes) < n; i++ {
+		node := r.points[(start+i)%len(r.points)].node
+		if !seen.Contains(node) {
+			seen.Add(node)
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes, nil
+}
+
+// Acquire returns the first node in ring order for the key whose load is below its capacity and increases its load,
+// so that no node exceeds the average load times the load factor, scaled by its weight. Call Release with the node
+// once the key is done. It returns ErrEmptyRing if the ring has no nodes.
+func (r *HashRing[N]) Acquire(key string) (N, error) {
+	if len(r.points) == 0 {
+		var zero N
+		return zero, ErrEmptyRing
+	}
+
+	totalWeight := 0
+	for _, w := range r.weights {
+		totalWeight += w
+	}
+	average := float64(r.totalLoad+1) / float64(totalWeight)
+
+	start := r.search(key)
+	for i := 0; ; i++ {
+		node := r.points[(start+i)%len(r.points)].node
+		capacity := int(math.Ceil(average * r.options.loadFactor * float64(r.weights[node])))
+		if r.loads[node] < capacity {
+			r.loads[node]++
+			r.totalLoad++
+			return node, nil
+		}
+	}
+}
+
+// Release decreases the load of the node that was increased by Acquire.
+func (r *HashRing[N]) Release(node N) {
+	if r.loads[node] > 0 {
+		r.loads[node]--
+		r.totalLoad--
+	}
+}
+
+// Loads returns the current load of every node acquired with Acquire.
+func (r *HashRing[N]) Loads() Dictionary[N, int] {
+	loads := make(Dictionary[N, int], r.weights.Size())
+	for node := range r.weights {
+		loads[node] = r.loads[node]
+	}
+	return loads
+}
+
+// search returns the position of the first point at or after the hash of the key, wrapping around the ring.
+func (r *HashRing[N]) search(key string) int {
+	h := r.options.hasher(key)
+	i := sort.Search(len(r.points), func(i int) bool {
+		return r.points[i].hash >= h
+	})
+	if i == len(r.points) {
+		return 0
+	}
+	return i
+}
+
+func nodeName[N comparable](node N) string {
+	switch n := any(node).(type) {
+	case string:
+		return n
+	case fmt.Stringer:
+		return n.String()
+	}
+	return fmt.Sprint(node)
+}
+
+func ringHash(s string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(s))
+	return mix64(h.Sum64())
+}
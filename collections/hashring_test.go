@@ -0,0 +1,217 @@
+package collections
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+type worker string
+
+func (w worker) String() string {
+	return string(w)
+}
+
+func ringKeys(n int) []string {
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = "key-" + strconv.Itoa(i)
+	}
+	return keys
+}
+
+func ringOwners(r *HashRing[worker], keys []string) map[string]worker {
+	owners := make(map[string]worker, len(keys))
+	for _, k := range keys {
+		owners[k], _ = r.Get(k)
+	}
+	return owners
+}
+
+func TestHashRing_Get(t *testing.T) {
+	r := NewHashRing[worker]()
+
+	_, err := r.Get("a")
+	assert.ErrorIs(t, err, ErrEmptyRing)
+
+	assert.True(t, r.Add("w1"))
+	assert.True(t, r.Add("w2"))
+	assert.True(t, r.Add("w3"))
+	assert.False(t, r.Add("w1"))
+	assert.Equal(t, 3, r.Size())
+
+	counts := map[worker]int{}
+	for _, owner := range ringOwners(r, ringKeys(30000)) {
+		counts[owner]++
+	}
+	for _, count := range counts {
+		assert.InDelta(t, 10000, count, 2000)
+	}
+
+	other := NewHashRing[worker]()
+	other.Add("w3")
+	other.Add("w1")
+	other.Add("w2")
+	assert.Equal(t, ringOwners(r, ringKeys(1000)), ringOwners(other, ringKeys(1000)))
+}
+
+func TestHashRing_Add(t *testing.T) {
+	r := NewHashRing[worker]()
+	r.Add("w1")
+	r.Add("w2")
+	r.Add("w3")
+	before := ringOwners(r, ringKeys(20000))
+
+	r.Add("w4")
+	after := ringOwners(r, ringKeys(20000))
+
+	moved := 0
+	for k, owner := range after {
+		if owner != before[k] {
+			assert.Equal(t, worker("w4"), owner)
+			moved++
+		}
+	}
+	assert.InDelta(t, 5000, moved, 1500)
+}
+
+func TestHashRing_Remove(t *testing.T) {
+	r := NewHashRing[worker]()
+	r.Add("w1")
+	r.Add("w2")
+	r.Add("w3")
+	before := ringOwners(r, ringKeys(20000))
+
+	assert.True(t, r.Remove("w2"))
+	assert.False(t, r.Remove("w2"))
+	assert.False(t, r.Has("w2"))
+	after := ringOwners(r, ringKeys(20000))
+
+	for k, owner := range before {
+		if owner != "w2" {
+			assert.Equal(t, owner, after[k])
+		}
+	}
+	assert.ElementsMatch(t, []worker{"w1", "w3"}, r.Nodes())
+}
+
+func TestHashRing_AddWithWeight(t *testing.T) {
+	r := NewHashRing[worker](WithVirtualNodes(200))
+	r.AddWithWeight("big", 3)
+	r.Add("small")
+
+	counts := map[worker]int{}
+	for _, owner := range ringOwners(r, ringKeys(20000)) {
+		counts[owner]++
+	}
+	assert.InDelta(t, 15000, counts["big"], 1500)
+
+	assert.PanicsWithValue(t, ErrInvalidWeight, func() {
+		r.AddWithWeight("none", 0)
+	})
+}
+
+func TestHashRing_GetN(t *testing.T) {
+	r := NewHashRing[worker]()
+	r.Add("w1")
+	r.Add("w2")
+	r.Add("w3")
+
+	for _, k := range ringKeys(100) {
+		nodes, err := r.GetN(k, 2)
+		assert.NoError(t, err)
+		assert.Len(t, nodes, 2)
+		assert.NotEqual(t, nodes[0], nodes[1])
+
+		owner, _ := r.Get(k)
+		assert.Equal(t, owner, nodes[0])
+	}
+
+	nodes, err := r.GetN("a", 5)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []worker{"w1", "w2", "w3"}, nodes)
+
+	_, err = NewHashRing[worker]().GetN("a", 1)
+	assert.ErrorIs(t, err, ErrEmptyRing)
+}
+
+func TestHashRing_Acquire(t *testing.T) {
+	r := NewHashRing[worker](WithLoadFactor(1.1), WithVirtualNodes(10))
+	r.Add("w1")
+	r.Add("w2")
+	r.Add("w3")
+
+	for i := 0; i < 3000; i++ {
+		// A hot key always starts from the same node.
+		_, err := r.Acquire("hot")
+		assert.NoError(t, err)
+	}
+
+	loads := r.Loads()
+	for _, load := range loads {
+		assert.LessOrEqual(t, load, 1100)
+	}
+
+	owner, _ := r.Get("hot")
+	r.Release(owner)
+	assert.Equal(t, loads[owner]-1, r.Loads()[owner])
+
+	r.Remove(owner)
+	assert.NotContains(t, r.Loads(), owner)
+
+	assert.PanicsWithValue(t, ErrInvalidLoadFactor, func() {
+		WithLoadFactor(1)
+	})
+}
+
+func TestHashRing_InvalidVirtualNodes(t *testing.T) {
+	assert.PanicsWithValue(t, ErrInvalidVirtualNodes, func() {
+		WithVirtualNodes(0)
+	})
+	assert.PanicsWithValue(t, ErrInvalidVirtualNodes, func() {
+		WithVirtualNodes(-1)
+	})
+
+	r := NewHashRing[worker](WithVirtualNodes(1))
+	r.Add("w1")
+	owner, err := r.Get("a")
+	assert.NoError(t, err)
+	assert.Equal(t, worker("w1"), owner)
+}
+
+func TestHashRing_Names(t *testing.T) {
+	workers := NewHashRing[worker]()
+	names := NewHashRing[string]()
+	for _, n := range []string{"w1", "w2", "w3"} {
+		workers.Add(worker(n))
+		names.Add(n)
+	}
+	for _, k := range ringKeys(1000) {
+		w, _ := workers.Get(k)
+		n, _ := names.Get(k)
+		assert.Equal(t, string(w), n)
+	}
+
+	ids := NewHashRing[int]()
+	named := NewHashRingFunc(strconv.Itoa)
+	for i := 1; i <= 3; i++ {
+		ids.Add(i)
+		named.Add(i)
+	}
+	for _, k := range ringKeys(1000) {
+		i, _ := ids.Get(k)
+		n, _ := named.Get(k)
+		assert.Equal(t, i, n)
+	}
+
+	type shard struct {
+		region string
+		index  int
+	}
+	shards := NewHashRingFunc(func(s shard) string { return s.region + "/" + strconv.Itoa(s.index) })
+	shards.Add(shard{"eu", 1})
+	shards.Add(shard{"us", 1})
+	owner, err := shards.Get("a")
+	assert.NoError(t, err)
+	assert.Contains(t, []shard{{"eu", 1}, {"us", 1}}, owner)
+}
@@ -0,0 +1,372 @@
+package collections
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+type (
+
+	// Interval is a range of ordered values that always includes its low bound, and includes its high bound when it
+	// is closed.
+	Interval[K constraints.Ordered] struct {
+		low    K
+		high   K
+		closed bool
+	}
+
+	// IntervalTree is an augmented balanced binary search tree that maps intervals to values and finds the intervals
+	// containing a point or overlapping a range. The entries are iterated in order of their intervals.
+	IntervalTree[K constraints.Ordered, V any] struct {
+		root *intervalNode[K, V]
+		size int
+	}
+
+	intervalNode[K constraints.Ordered, V any] struct {
+		interval Interval[K]
+		value    V
+		left     *intervalNode[K, V]
+		right    *intervalNode[K, V]
+		height   int
+		// maxEnd is the furthest end of the intervals in the subtree.
+		maxEnd Interval[K]
+	}
+)
+
+var _ Iterable[*Entry[Interval[int], any]] = (*IntervalTree[int, any])(nil)
+
+var (
+	ErrInvalidInterval = errors.New("interval low bound is greater than its high bound")
+)
+
+// NewClosedInterval returns the interval [low, high], it panics with ErrInvalidInterval if low is greater than high.
+func NewClosedInterval[K constraints.Ordered](low, high K) Interval[K] {
+	return newInterval(low, high, true)
+}
+
+// NewHalfOpenInterval returns the interval [low, high), it panics with ErrInvalidInterval if low is greater than high.
+func NewHalfOpenInterval[K constraints.Ordered](low, high K) Interval[K] {
+	return newInterval(low, high, false)
+}
+
+func newInterval[K constraints.Ordered](low, high K, closed bool) Interval[K] {
+	if high < low {
+		panic(ErrInvalidInterval)
+	}
+	return Interval[K]{low: low, high: high, closed: closed}
+}
+
+// Low returns the low bound of the interval.
+func (i Interval[K]) Low() K {
+	return i.low
+}
+
+// High returns the high bound of the interval.
+func (i Interval[K]) High() K {
+	return i.high
+}
+
+// IsClosed returns true if the interval includes its high bound.
+func (i Interval[K]) IsClosed() bool {
+	return i.closed
+}
+
+// IsEmpty returns true if the interval contains no value, which is the case of [x, x).
+func (i Interval[K]) IsEmpty() bool {
+	return !i.closed && i.low == i.high
+}
+
+// Contains returns true if the value is inside the interval.
+func (i Interval[K]) Contains(k K) bool {
+	return i.low <= k && i.endsAfter(k)
+}
+
+// Overlaps returns true if both intervals have at least one value in common.
+func (i Interval[K]) Overlaps(other Interval[K]) bool {
+	return !i.IsEmpty() && !other.IsEmpty() && i.endsAfter(other.low) && other.endsAfter(i.low)
+}
+
+// String returns the interval in mathematical notation.
+func (i Interval[K]) String() string {
+	if i.closed {
+		return fmt.Sprintf("[%v, %v]", i.low, i.high)
+	}
+	return fmt.Sprintf("[%v, %v)", i.low, i.high)
+}
+
+// endsAfter returns true if the value is before the end of the interval.
+func (i Interval[K]) endsAfter(k K) bool {
+	return k < i.high || (i.closed && k == i.high)
+}
+
+// compare orders the intervals by low bound, then by end.
+func (i Interval[K]) compare(other Interval[K]) int {
+	switch {
+	case i.low < other.low:
+		return -1
+	case i.low > other.low:
+		return 1
+	}
+	return i.compareEnd(other)
+}
+
+func (i Interval[K]) compareEnd(other Interval[K]) int {
+	switch {
+	case i.high < other.high:
+		return -1
+	case i.high > other.high:
+		return 1
+	case i.closed == other.closed:
+		return 0
+	case other.closed:
+		return -1
+	}
+	return 1
+}
+
+// NewIntervalTree returns a new empty IntervalTree.
+func NewIntervalTree[K constraints.Ordered, V any]() *IntervalTree[K, V] {
+	return &IntervalTree[K, V]{}
+}
+
+// Insert sets the value of the interval, it returns true if the interval did not exist.
+func (t *IntervalTree[K, V]) Insert(interval Interval[K], value V) bool {
+	var added bool
+	t.root, added = t.root.insert(interval, value)
+	if added {
+		t.size++
+	}
+	return added
+}
+
+// Get returns the value of the interval, or ErrKeyNotFound if the interval does not exist.
+func (t *IntervalTree[K, V]) Get(interval Interval[K]) (V, error) {
+	for n := t.root; n != nil; {
+		switch c := interval.compare(n.interval); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.value, nil
+		}
+	}
+	var zero V
+	return zero, ErrKeyNotFound
+}
+
+// Has returns true if the interval exists.
+func (t *IntervalTree[K, V]) Has(interval Interval[K]) bool {
+	_, err := t.Get(interval)
+	return err == nil
+}
+
+// Remove removes the interval, it returns true if the interval existed.
+func (t *IntervalTree[K, V]) Remove(interval Interval[K]) bool {
+	var removed bool
+	t.root, removed = t.root.remove(interval)
+	if removed {
+		t.size--
+	}
+	return removed
+}
+
+// Size returns the number of intervals in the tree.
+func (t *IntervalTree[K, V]) Size() int {
+	return t.size
+}
+
+// IsEmpty returns true if the tree contains no intervals.
+func (t *IntervalTree[K, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Clear removes all the intervals.
+func (t *IntervalTree[K, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// Containing returns the entries whose interval contains the value, in order of their intervals.
+func (t *IntervalTree[K, V]) Containing(k K) []*Entry[Interval[K], V] {
+	return t.Overlapping(NewClosedInterval(k, k))
+}
+
+// Overlapping returns the entries whose interval overlaps the interval, in order of their intervals.
+func (t *IntervalTree[K, V]) Overlapping(interval Interval[K]) []*Entry[Interval[K], V] {
+	var entries []*Entry[Interval[K], V]
+	if !interval.IsEmpty() {
+		t.root.overlapping(interval, func(n *intervalNode[K, V]) {
+			entries = append(entries, &Entry[Interval[K], V]{key: n.interval, value: n.value})
+		})
+	}
+	return entries
+}
+
+// Intervals returns the intervals of the tree in order.
+func (t *IntervalTree[K, V]) Intervals() []Interval[K] {
+	intervals := make([]Interval[K], 0, t.size)
+	t.root.walk(func(n *intervalNode[K, V]) {
+		intervals = append(intervals, n.interval)
+	})
+	return intervals
+}
+
+// Entries returns the interval/value pairs of the tree in order of their intervals.
+func (t *IntervalTree[K, V]) Entries() []*Entry[Interval[K], V] {
+	entries := make([]*Entry[Interval[K], V], 0, t.size)
+	t.root.walk(func(n *intervalNode[K, V]) {
+		entries = append(entries, &Entry[Interval[K], V]{key: n.interval, value: n.value})
+	})
+	return entries
+}
+
+// Iterator returns an iterator over the entries of the tree in order of their intervals.
+func (t *IntervalTree[K, V]) Iterator() Iterator[*Entry[Interval[K], V]] {
+	return IteratorFromSlice(t.Entries())
+}
+
+// Merged returns the union of the intervals in the tree as the fewest ordered intervals, joining the overlapping and
+// adjacent ones such as [1, 3) and [3, 5).
+func (t *IntervalTree[K, V]) Merged() []Interval[K] {
+	var merged []Interval[K]
+	t.root.walk(func(n *intervalNode[K, V]) {
+		if n.interval.IsEmpty() {
+			return
+		}
+		last := len(merged) - 1
+		if last < 0 || merged[last].high < n.interval.low {
+			merged = append(merged, n.interval)
+			return
+		}
+		if merged[last].compareEnd(n.interval) < 0 {
+			merged[last].high, merged[last].closed = n.interval.high, n.interval.closed
+		}
+	})
+	return merged
+}
+
+func (n *intervalNode[K, V]) insert(interval Interval[K], value V) (*intervalNode[K, V], bool) {
+	if n == nil {
+		return &intervalNode[K, V]{interval: interval, value: value, height: 1, maxEnd: interval}, true
+	}
+
+	var added bool
+	switch c := interval.compare(n.interval); {
+	case c < 0:
+		n.left, added = n.left.insert(interval, value)
+	case c > 0:
+		n.right, added = n.right.insert(interval, value)
+	default:
+		n.value = value
+		return n, false
+	}
+	return n.rebalance(), added
+}
+
+func (n *intervalNode[K, V]) remove(interval Interval[K]) (*intervalNode[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	var removed bool
+	switch c := interval.compare(n.interval); {
+	case c < 0:
+		n.left, removed = n.left.remove(interval)
+	case c > 0:
+		n.right, removed = n.right.remove(interval)
+	default:
+		if n.left == nil {
+			return n.right, true
+		}
+		if n.right == nil {
+			return n.left, true
+		}
+		successor := n.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		n.interval, n.value = successor.interval, successor.value
+		n.right, _ = n.right.remove(successor.interval)
+		removed = true
+	}
+	return n.rebalance(), removed
+}
+
+func (n *intervalNode[K, V]) overlapping(interval Interval[K], fn func(*intervalNode[K, V])) {
+	if n == nil || !n.maxEnd.endsAfter(interval.low) {
+		return
+	}
+	n.left.overlapping(interval, fn)
+	if n.interval.Overlaps(interval) {
+		fn(n)
+	}
+	// The intervals on the right do not start before this one.
+	if interval.endsAfter(n.interval.low) {
+		n.right.overlapping(interval, fn)
+	}
+}
+
+func (n *intervalNode[K, V]) walk(fn func(*intervalNode[K, V])) {
+	if n == nil {
+		return
+	}
+	n.left.walk(fn)
+	fn(n)
+	n.right.walk(fn)
+}
+
+func (n *intervalNode[K, V]) getHeight() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *intervalNode[K, V]) update() {
+	n.height = 1 + n.left.getHeight()
+	if h := n.right.getHeight(); h >= n.height {
+		n.height = h + 1
+	}
+	n.maxEnd = n.interval
+	for _, child := range []*intervalNode[K, V]{n.left, n.right} {
+		if child != nil && n.maxEnd.compareEnd(child.maxEnd) < 0 {
+			n.maxEnd = child.maxEnd
+		}
+	}
+}
+
+func (n *intervalNode[K, V]) rebalance() *intervalNode[K, V] {
+	n.update()
+	switch balance := n.left.getHeight() - n.right.getHeight(); {
+	case balance > 1:
+		if n.left.left.getHeight() < n.left.right.getHeight() {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	case balance < -1:
+		if n.right.right.getHeight() < n.right.left.getHeight() {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+	return n
+}
+
+func (n *intervalNode[K, V]) rotateLeft() *intervalNode[K, V] {
+	r := n.right
+	n.right, r.left = r.left, n
+	n.update()
+	r.update()
+	return r
+}
+
+func (n *intervalNode[K, V]) rotateRight() *intervalNode[K, V] {
+	l := n.left
+	n.left, l.right = l.right, n
+	n.update()
+	l.update()
+	return l
+}
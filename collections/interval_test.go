@@ -0,0 +1,185 @@
+package collections
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+)
+
+func TestInterval_Contains(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval Interval[int]
+		value    int
+		expected bool
+	}{
+		{name: "Closed low", interval: NewClosedInterval(1, 3), value: 1, expected: true},
+		{name: "Closed high", interval: NewClosedInterval(1, 3), value: 3, expected: true},
+		{name: "Half open high", interval: NewHalfOpenInterval(1, 3), value: 3, expected: false},
+		{name: "Before", interval: NewHalfOpenInterval(1, 3), value: 0, expected: false},
+		{name: "Empty", interval: NewHalfOpenInterval(1, 1), value: 1, expected: false},
+		{name: "Single point", interval: NewClosedInterval(1, 1), value: 1, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.interval.Contains(tt.value))
+		})
+	}
+}
+
+func TestInterval_Overlaps(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     Interval[int]
+		expected bool
+	}{
+		{name: "Overlapping", a: NewHalfOpenInterval(1, 5), b: NewHalfOpenInterval(4, 8), expected: true},
+		{name: "Adjacent half open", a: NewHalfOpenInterval(1, 3), b: NewHalfOpenInterval(3, 5), expected: false},
+		{name: "Touching closed", a: NewClosedInterval(1, 3), b: NewHalfOpenInterval(3, 5), expected: true},
+		{name: "Nested", a: NewClosedInterval(1, 10), b: NewClosedInterval(4, 5), expected: true},
+		{name: "Disjoint", a: NewClosedInterval(1, 2), b: NewClosedInterval(4, 5), expected: false},
+		{name: "Empty", a: NewHalfOpenInterval(3, 3), b: NewClosedInterval(1, 5), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.a.Overlaps(tt.b))
+			assert.Equal(t, tt.expected, tt.b.Overlaps(tt.a))
+		})
+	}
+}
+
+func TestNewClosedInterval_Invalid(t *testing.T) {
+	assert.PanicsWithValue(t, ErrInvalidInterval, func() {
+		NewClosedInterval(3, 1)
+	})
+	assert.Equal(t, "[1, 3)", NewHalfOpenInterval(1, 3).String())
+	assert.Equal(t, "[1, 3]", NewClosedInterval(1, 3).String())
+}
+
+func TestIntervalTree_Insert(t *testing.T) {
+	tree := NewIntervalTree[int, string]()
+
+	assert.True(t, tree.Insert(NewClosedInterval(5, 8), "b"))
+	assert.True(t, tree.Insert(NewHalfOpenInterval(1, 3), "a"))
+	assert.True(t, tree.Insert(NewClosedInterval(1, 3), "c"))
+	assert.False(t, tree.Insert(NewClosedInterval(5, 8), "d"))
+
+	assert.Equal(t, 3, tree.Size())
+	assert.Equal(t, []Interval[int]{NewHalfOpenInterval(1, 3), NewClosedInterval(1, 3), NewClosedInterval(5, 8)}, tree.Intervals())
+
+	v, err := tree.Get(NewClosedInterval(5, 8))
+	assert.NoError(t, err)
+	assert.Equal(t, "d", v)
+
+	_, err = tree.Get(NewHalfOpenInterval(5, 8))
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+}
+
+func TestIntervalTree_Remove(t *testing.T) {
+	tree := NewIntervalTree[int, int]()
+	for i := 0; i < 10; i++ {
+		tree.Insert(NewHalfOpenInterval(i, i+2), i)
+	}
+
+	assert.True(t, tree.Remove(NewHalfOpenInterval(4, 6)))
+	assert.False(t, tree.Remove(NewHalfOpenInterval(4, 6)))
+	assert.False(t, tree.Has(NewHalfOpenInterval(4, 6)))
+	assert.Equal(t, 9, tree.Size())
+	assert.Equal(t, []*Entry[Interval[int], int]{
+		NewEntry(NewHalfOpenInterval(3, 5), 3),
+		NewEntry(NewHalfOpenInterval(5, 7), 5),
+	}, tree.Overlapping(NewClosedInterval(4, 5)))
+
+	tree.Clear()
+	assert.True(t, tree.IsEmpty())
+	assert.Empty(t, tree.Entries())
+}
+
+func TestIntervalTree_Overlapping(t *testing.T) {
+	tree := NewIntervalTree[int, string]()
+	tree.Insert(NewHalfOpenInterval(0, 10), "morning")
+	tree.Insert(NewClosedInterval(9, 12), "meeting")
+	tree.Insert(NewHalfOpenInterval(12, 18), "afternoon")
+
+	tests := []struct {
+		name     string
+		interval Interval[int]
+		expected []string
+	}{
+		{name: "Single", interval: NewHalfOpenInterval(1, 2), expected: []string{"morning"}},
+		{name: "Closed end", interval: NewHalfOpenInterval(12, 13), expected: []string{"meeting", "afternoon"}},
+		{name: "Half open end", interval: NewHalfOpenInterval(8, 9), expected: []string{"morning"}},
+		{name: "All", interval: NewClosedInterval(0, 20), expected: []string{"morning", "meeting", "afternoon"}},
+		{name: "None", interval: NewClosedInterval(18, 20), expected: nil},
+		{name: "Empty", interval: NewHalfOpenInterval(9, 9), expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var actual []string
+			for _, e := range tree.Overlapping(tt.interval) {
+				actual = append(actual, e.Value())
+			}
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestIntervalTree_Merged(t *testing.T) {
+	tree := NewIntervalTree[int, any]()
+	tree.Insert(NewHalfOpenInterval(1, 3), nil)
+	tree.Insert(NewHalfOpenInterval(3, 5), nil)
+	tree.Insert(NewClosedInterval(2, 5), nil)
+	tree.Insert(NewHalfOpenInterval(7, 7), nil)
+	tree.Insert(NewHalfOpenInterval(8, 9), nil)
+	tree.Insert(NewHalfOpenInterval(10, 12), nil)
+	tree.Insert(NewClosedInterval(10, 11), nil)
+
+	assert.Equal(t, []Interval[int]{
+		NewClosedInterval(1, 5),
+		NewHalfOpenInterval(8, 9),
+		NewHalfOpenInterval(10, 12),
+	}, tree.Merged())
+}
+
+func TestIntervalTree_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	tree := NewIntervalTree[int, int]()
+	expected := map[Interval[int]]int{}
+
+	random := func() Interval[int] {
+		low := r.Intn(200)
+		return newInterval(low, low+r.Intn(20), r.Intn(2) == 0)
+	}
+
+	for i := 0; i < 3000; i++ {
+		interval := random()
+		if r.Intn(3) == 0 {
+			_, ok := expected[interval]
+			assert.Equal(t, ok, tree.Remove(interval))
+			delete(expected, interval)
+		} else {
+			tree.Insert(interval, i)
+			expected[interval] = i
+		}
+	}
+	assert.Equal(t, len(expected), tree.Size())
+
+	for i := 0; i < 200; i++ {
+		query := random()
+		var count int
+		for interval := range expected {
+			if interval.Overlaps(query) {
+				count++
+			}
+		}
+		actual := tree.Overlapping(query)
+		assert.Len(t, actual, count)
+		for _, e := range actual {
+			assert.True(t, e.Key().Overlaps(query))
+			assert.Equal(t, expected[e.Key()], e.Value())
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package collections
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+type (
+	// SliceStrategy defines how DeepMerge combines slices found under the same key.
+	SliceStrategy int
+)
+
+const (
+	// SliceReplace replaces the destination slice with the source slice.
+	SliceReplace SliceStrategy = iota
+
+	// SliceAppend appends the source slice elements to the destination slice.
+	SliceAppend
+
+	// SliceUnique appends the source slice elements that are not already present in the destination slice.
+	SliceUnique
+)
+
+// PathSeparator is the separator used between keys by Flatten, Unflatten, GetPath and SetPath.
+const PathSeparator = "."
+
+var (
+	ErrPathConflict = errors.New("path conflicts with a non dictionary value")
+	ErrTypeMismatch = errors.New("value type mismatch")
+)
+
+// DeepMerge merges src into dst, recursing into nested dictionaries. Slices found under the same key are combined
+// according to the strategy, any other value in src overwrites the value in dst. Nested dictionaries in src are
+// copied so dst does not share state with src.
+func DeepMerge(dst, src Dictionary[string, any], strategy SliceStrategy) {
+	for k, v := range src {
+		current, ok := dst[k]
+		if !ok {
+			dst[k] = deepCopy(v)
+			continue
+		}
+
+		if cm, ok := asDictionary(current); ok {
+			if sm, ok := asDictionary(v); ok {
+				DeepMerge(cm, sm, strategy)
+				continue
+			}
+		}
+
+		dst[k] = mergeValue(current, deepCopy(v), strategy)
+	}
+}
+
+// Flatten returns a new single level dictionary whose keys are the paths of the leaves of the nested dictionary,
+// joined with PathSeparator. Empty nested dictionaries are kept as leaves.
+func Flatten(d Dictionary[string, any]) Dictionary[string, any] {
+	result := make(Dictionary[string, any])
+	flatten(result, "", d)
+	return result
+}
+
+func flatten(result Dictionary[string, any], prefix string, d Dictionary[string, any]) {
+	for k, v := range d {
+		key := k
+		if prefix != "" {
+			key = prefix + PathSeparator + k
+		}
+		if m, ok := asDictionary(v); ok && len(m) > 0 {
+			flatten(result, key, m)
+			continue
+		}
+		result[key] = v
+	}
+}
+
+// Unflatten returns a new nested dictionary built from the keys of d split by PathSeparator, it reverses Flatten.
+// It returns ErrPathConflict if a key is both a leaf and the prefix of another key. The keys are applied in sorted
+// order, so a dictionary value is always extended by the longer keys under it rather than replacing them.
+func Unflatten(d Dictionary[string, any]) (Dictionary[string, any], error) {
+	keys := d.Keys()
+	sort.Strings(keys)
+
+	result := make(Dictionary[string, any])
+	for _, k := range keys {
+		if err := SetPath(result, k, deepCopy(d[k])); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// GetPath returns the value found following the path, made of keys joined with PathSeparator, through the nested
+// dictionaries. It returns ErrKeyNotFound if the path does not exist and ErrTypeMismatch if the value is not a T.
+func GetPath[T any](d Dictionary[string, any], path string) (T, error) {
+	var zero T
+	keys := strings.Split(path, PathSeparator)
+	current := d
+	for _, k := range keys[:len(keys)-1] {
+		m, ok := asDictionary(current[k])
+		if !ok {
+			return zero, ErrKeyNotFound
+		}
+		current = m
+	}
+
+	v, ok := current[keys[len(keys)-1]]
+	if !ok {
+		return zero, ErrKeyNotFound
+	}
+	t, ok := v.(T)
+	if !ok {
+		return zero, ErrTypeMismatch
+	}
+	return t, nil
+}
+
+// SetPath sets the value at the path, made of keys joined with PathSeparator, creating the intermediate
+// dictionaries as needed. It returns ErrPathConflict if an intermediate key holds a value that is not a dictionary,
+// or if the path holds a non empty dictionary and value is not a dictionary.
+func SetPath(d Dictionary[string, any], path string, value any) error {
+	keys := strings.Split(path, PathSeparator)
+	current := d
+	for _, k := range keys[:len(keys)-1] {
+		v, ok := current[k]
+		if !ok {
+			next := make(Dictionary[string, any])
+			current[k] = next
+			current = next
+			continue
+		}
+		m, ok := asDictionary(v)
+		if !ok {
+			return ErrPathConflict
+		}
+		current = m
+	}
+
+	last := keys[len(keys)-1]
+	if m, ok := asDictionary(current[last]); ok && len(m) > 0 {
+		if _, ok := asDictionary(value); !ok {
+			return ErrPathConflict
+		}
+	}
+	current[last] = value
+	return nil
+}
+
+// asDictionary returns v as a Dictionary if it is a Dictionary[string, any] or a map[string]any, the kind of value
+// produced when decoding JSON or YAML documents.
+func asDictionary(v any) (Dictionary[string, any], bool) {
+	switch m := v.(type) {
+	case Dictionary[string, any]:
+		return m, m != nil
+	case map[string]any:
+		return m, m != nil
+	}
+	return nil, false
+}
+
+func deepCopy(v any) any {
+	m, ok := asDictionary(v)
+	if !ok {
+		return v
+	}
+	result := make(Dictionary[string, any], len(m))
+	for k, e := range m {
+		result[k] = deepCopy(e)
+	}
+	return result
+}
+
+func mergeValue(dst, src any, strategy SliceStrategy) any {
+	if strategy == SliceReplace {
+		return src
+	}
+
+	dv, sv := reflect.ValueOf(dst), reflect.ValueOf(src)
+	if dv.Kind() != reflect.Slice || sv.Kind() != reflect.Slice || dv.Type() != sv.Type() {
+		return src
+	}
+
+	result := reflect.MakeSlice(dv.Type(), 0, dv.Len()+sv.Len())
+	result = reflect.AppendSlice(result, dv)
+	for i := 0; i < sv.Len(); i++ {
+		e := sv.Index(i)
+		if strategy == SliceUnique && containsValue(result, e) {
+			continue
+		}
+		result = reflect.Append(result, e)
+	}
+	return result.Interface()
+}
+
+func containsValue(s reflect.Value, e reflect.Value) bool {
+	for i := 0; i < s.Len(); i++ {
+		if Equal(s.Index(i).Interface(), e.Interface()) {
+			return true
+		}
+	}
+	return false
+}
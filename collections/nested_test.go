@@ -0,0 +1,145 @@
+package collections
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDeepMerge(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy SliceStrategy
+		want     []any
+	}{
+		{
+			name:     "Replace slices",
+			strategy: SliceReplace,
+			want:     []any{"b", "c"},
+		},
+		{
+			name:     "Append slices",
+			strategy: SliceAppend,
+			want:     []any{"a", "b", "b", "c"},
+		},
+		{
+			name:     "Unique slices",
+			strategy: SliceUnique,
+			want:     []any{"a", "b", "c"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dst := Dictionary[string, any]{
+				"server": map[string]any{"host": "localhost", "port": 80, "tags": []any{"a", "b"}},
+				"debug":  false,
+			}
+			src := Dictionary[string, any]{
+				"server": map[string]any{"port": 8080, "tags": []any{"b", "c"}},
+				"debug":  true,
+				"db":     map[string]any{"name": "app"},
+			}
+
+			DeepMerge(dst, src, tt.strategy)
+
+			assert.Equal(t, Dictionary[string, any]{
+				"server": map[string]any{"host": "localhost", "port": 8080, "tags": tt.want},
+				"debug":  true,
+				"db":     Dictionary[string, any]{"name": "app"},
+			}, dst)
+		})
+	}
+}
+
+func TestDeepMerge_CopiesSource(t *testing.T) {
+	dst := Dictionary[string, any]{}
+	src := Dictionary[string, any]{"db": map[string]any{"name": "app"}}
+
+	DeepMerge(dst, src, SliceReplace)
+	assert.NoError(t, SetPath(dst, "db.name", "other"))
+
+	assert.Equal(t, map[string]any{"name": "app"}, src["db"])
+}
+
+func TestFlatten(t *testing.T) {
+	d := Dictionary[string, any]{
+		"a": map[string]any{"b": map[string]any{"c": 1}, "d": []int{1, 2}},
+		"e": "f",
+		"g": map[string]any{},
+	}
+
+	assert.Equal(t, Dictionary[string, any]{
+		"a.b.c": 1,
+		"a.d":   []int{1, 2},
+		"e":     "f",
+		"g":     map[string]any{},
+	}, Flatten(d))
+}
+
+func TestUnflatten(t *testing.T) {
+	actual, err := Unflatten(Dictionary[string, any]{"a.b.c": 1, "a.d": 2, "e": "f"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, Dictionary[string, any]{
+		"a": Dictionary[string, any]{"b": Dictionary[string, any]{"c": 1}, "d": 2},
+		"e": "f",
+	}, actual)
+	assert.Equal(t, Dictionary[string, any]{"a.b.c": 1, "a.d": 2, "e": "f"}, Flatten(actual))
+}
+
+func TestUnflatten_Conflict(t *testing.T) {
+	_, err := Unflatten(Dictionary[string, any]{"a": 1, "a.b": 2})
+
+	assert.ErrorIs(t, err, ErrPathConflict)
+}
+
+func TestUnflatten_DictionaryValue(t *testing.T) {
+	for i := 0; i < 20; i++ {
+		empty := Dictionary[string, any]{}
+		actual, err := Unflatten(Dictionary[string, any]{"a": empty, "a.b": 1, "c": map[string]any{"d": 2}, "c.e": 3})
+
+		assert.NoError(t, err)
+		assert.Equal(t, Dictionary[string, any]{
+			"a": Dictionary[string, any]{"b": 1},
+			"c": Dictionary[string, any]{"d": 2, "e": 3},
+		}, actual)
+		assert.Empty(t, empty)
+	}
+}
+
+func TestGetPath(t *testing.T) {
+	d := Dictionary[string, any]{
+		"server": map[string]any{"port": 8080, "tls": Dictionary[string, any]{"enabled": true}},
+	}
+
+	port, err := GetPath[int](d, "server.port")
+	assert.NoError(t, err)
+	assert.Equal(t, 8080, port)
+
+	enabled, err := GetPath[bool](d, "server.tls.enabled")
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+
+	_, err = GetPath[string](d, "server.port")
+	assert.ErrorIs(t, err, ErrTypeMismatch)
+
+	_, err = GetPath[any](d, "server.host")
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+
+	_, err = GetPath[any](d, "server.port.value")
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+}
+
+func TestSetPath(t *testing.T) {
+	d := Dictionary[string, any]{"server": map[string]any{"port": 80}}
+
+	assert.NoError(t, SetPath(d, "server.port", 8080))
+	assert.NoError(t, SetPath(d, "db.name", "app"))
+	assert.ErrorIs(t, SetPath(d, "server.port.value", 1), ErrPathConflict)
+	assert.ErrorIs(t, SetPath(d, "server", 1), ErrPathConflict)
+
+	assert.Equal(t, Dictionary[string, any]{
+		"server": map[string]any{"port": 8080},
+		"db":     Dictionary[string, any]{"name": "app"},
+	}, d)
+}
@@ -0,0 +1,435 @@
+package collections
+
+type (
+	// ChangeType identifies the kind of change made to an observable collection.
+	ChangeType int
+
+	// Change describes a change made to an observable collection.
+	Change[K any, T any] struct {
+		changeType ChangeType
+		key        K
+		value      T
+		oldValue   T
+	}
+
+	// ChangeListener is a function that receives the changes made to an observable collection. Changes made outside of
+	// a batch are delivered one at a time, changes made inside a batch are delivered together when the batch ends.
+	ChangeListener[K any, T any] func([]*Change[K, T])
+
+	// ObservableList is a List that notifies its listeners of every change, the key of a change is the index of the element.
+	ObservableList[T any] struct {
+		observers[int, T]
+		list List[T]
+	}
+
+	// ObservableSet is a Set that notifies its listeners of every change, the key of a change is the element.
+	ObservableSet[T comparable] struct {
+		observers[T, T]
+		set Set[T]
+	}
+
+	// ObservableMap is a Map that notifies its listeners of every change.
+	ObservableMap[K comparable, T any] struct {
+		observers[K, T]
+		m Map[K, T]
+	}
+
+	observers[K any, T any] struct {
+		listeners []*subscription[K, T]
+		depth     int
+		pending   []*Change[K, T]
+	}
+
+	subscription[K any, T any] struct {
+		listener ChangeListener[K, T]
+	}
+)
+
+const (
+	// ChangeAdded is the change of an element added to the collection.
+	ChangeAdded ChangeType = iota
+
+	// ChangeRemoved is the change of an element removed from the collection.
+	ChangeRemoved
+
+	// ChangeReplaced is the change of an element replaced by another.
+	ChangeReplaced
+
+	// ChangeCleared is the change of a collection that had all its elements removed.
+	ChangeCleared
+)
+
+var (
+	_ List[any]        = (*ObservableList[any])(nil)
+	_ Set[string]      = (*ObservableSet[string])(nil)
+	_ Map[string, any] = (*ObservableMap[string, any])(nil)
+)
+
+// String returns the name of the change type.
+func (c ChangeType) String() string {
+	switch c {
+	case ChangeAdded:
+		return "added"
+	case ChangeRemoved:
+		return "removed"
+	case ChangeReplaced:
+		return "replaced"
+	case ChangeCleared:
+		return "cleared"
+	}
+	return "unknown"
+}
+
+// Type returns the type of the change.
+func (c *Change[K, T]) Type() ChangeType {
+	return c.changeType
+}
+
+// Key returns the index, element or key affected by the change, it is the zero value for ChangeCleared.
+func (c *Change[K, T]) Key() K {
+	return c.key
+}
+
+// Value returns the added or removed value, or the new value for ChangeReplaced.
+func (c *Change[K, T]) Value() T {
+	return c.value
+}
+
+// OldValue returns the replaced value for ChangeReplaced.
+func (c *Change[K, T]) OldValue() T {
+	return c.oldValue
+}
+
+// Subscribe registers a listener for the changes of the collection, it returns a function that removes the listener.
+func (o *observers[K, T]) Subscribe(listener ChangeListener[K, T]) func() {
+	s := &subscription[K, T]{listener: listener}
+	o.listeners = append(o.listeners, s)
+	return func() {
+		for i, l := range o.listeners {
+			if l == s {
+				o.listeners = append(o.listeners[:i:i], o.listeners[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// Batch runs fn and delivers all the changes it makes to the listeners at once when it returns, batches can be nested
+// and the changes are delivered when the outermost batch ends.
+func (o *observers[K, T]) Batch(fn func()) {
+	o.depth++
+	defer func() {
+		o.depth--
+		if o.depth > 0 || len(o.pending) == 0 {
+			return
+		}
+		changes := o.pending
+		o.pending = nil
+		o.notify(changes)
+	}()
+	fn()
+}
+
+func (o *observers[K, T]) emit(changeType ChangeType, key K, value, oldValue T) {
+	c := &Change[K, T]{changeType: changeType, key: key, value: value, oldValue: oldValue}
+	if o.depth > 0 {
+		o.pending = append(o.pending, c)
+		return
+	}
+	o.notify([]*Change[K, T]{c})
+}
+
+func (o *observers[K, T]) notify(changes []*Change[K, T]) {
+	for _, s := range o.listeners {
+		s.listener(changes)
+	}
+}
+
+// NewObservableList returns an ObservableList backed by the list, changes made directly to the list are not notified.
+func NewObservableList[T any](list List[T]) *ObservableList[T] {
+	return &ObservableList[T]{list: list}
+}
+
+// Add adds the specified element to the list. The index of the change is the position reported by lists that keep
+// their own order through an Insert method, such as SortedList, the last position for lists that append the element,
+// and -1 if the position cannot be known.
+func (o *ObservableList[T]) Add(t T) bool {
+	var i int
+	if l, ok := o.list.(interface{ Insert(T) int }); ok {
+		i = l.Insert(t)
+	} else {
+		if !o.list.Add(t) {
+			return false
+		}
+		i = o.list.Size() - 1
+		if !Equal(o.list.Get(i), t) {
+			i = -1
+		}
+	}
+	var zero T
+	o.emit(ChangeAdded, i, t, zero)
+	return true
+}
+
+// AddAt adds the specified element at the specified position in the list.
+func (o *ObservableList[T]) AddAt(i int, t T) bool {
+	if !o.list.AddAt(i, t) {
+		return false
+	}
+	var zero T
+	o.emit(ChangeAdded, i, t, zero)
+	return true
+}
+
+// AddAll adds all the elements in the specified collection to the list, notifying a change for each element.
+func (o *ObservableList[T]) AddAll(ts []T) bool {
+	added := true
+	for _, t := range ts {
+		added = o.Add(t) && added
+	}
+	return added
+}
+
+// Clear removes all the elements from the list.
+func (o *ObservableList[T]) Clear() {
+	if o.list.IsEmpty() {
+		return
+	}
+	o.list.Clear()
+	var zero T
+	o.emit(ChangeCleared, 0, zero, zero)
+}
+
+// Contains returns true if the list contains the specified element.
+func (o *ObservableList[T]) Contains(t T) bool {
+	return o.list.Contains(t)
+}
+
+// Get returns the element at the specified position in the list.
+func (o *ObservableList[T]) Get(i int) T {
+	return o.list.Get(i)
+}
+
+// IndexOf returns the index of the first occurrence of the specified element in the list, or -1 if the list does not contain the element.
+func (o *ObservableList[T]) IndexOf(t T) int {
+	return o.list.IndexOf(t)
+}
+
+// IsEmpty returns true if the list contains no elements.
+func (o *ObservableList[T]) IsEmpty() bool {
+	return o.list.IsEmpty()
+}
+
+// Iterator returns an iterator over the elements in the list.
+func (o *ObservableList[T]) Iterator() Iterator[T] {
+	return o.list.Iterator()
+}
+
+// Remove removes the first occurrence of the specified element from the list, if it is present.
+func (o *ObservableList[T]) Remove(t T) bool {
+	i := o.list.IndexOf(t)
+	if i == -1 {
+		return false
+	}
+	o.RemoveAt(i)
+	return true
+}
+
+// RemoveAt removes the element at the specified position in the list.
+func (o *ObservableList[T]) RemoveAt(i int) T {
+	v := o.list.RemoveAt(i)
+	var zero T
+	o.emit(ChangeRemoved, i, v, zero)
+	return v
+}
+
+// RemoveIf removes all the elements that satisfy the given predicate, notifying a change for each element. The index
+// of each change is the position of the element after the previous changes were applied.
+func (o *ObservableList[T]) RemoveIf(f Predicate[T]) bool {
+	removed := false
+	for i := 0; i < o.list.Size(); i++ {
+		if f(o.list.Get(i)) {
+			o.RemoveAt(i)
+			i -= 1
+			removed = true
+		}
+	}
+	return removed
+}
+
+// Set replaces the element at the specified position in the list with the specified element.
+func (o *ObservableList[T]) Set(i int, t T) T {
+	old := o.list.Set(i, t)
+	o.emit(ChangeReplaced, i, t, old)
+	return old
+}
+
+// Size returns the number of elements in the list.
+func (o *ObservableList[T]) Size() int {
+	return o.list.Size()
+}
+
+// ToArray returns an array containing all the elements in the list.
+func (o *ObservableList[T]) ToArray() []T {
+	return o.list.ToArray()
+}
+
+// NewObservableSet returns an ObservableSet backed by the set, changes made directly to the set are not notified.
+func NewObservableSet[T comparable](set Set[T]) *ObservableSet[T] {
+	return &ObservableSet[T]{set: set}
+}
+
+// Add adds the specified element to the set, a change is notified only if the element was not present.
+func (o *ObservableSet[T]) Add(t T) bool {
+	if o.set.Contains(t) {
+		return false
+	}
+	if !o.set.Add(t) {
+		return false
+	}
+	var zero T
+	o.emit(ChangeAdded, t, t, zero)
+	return true
+}
+
+// AddAll adds all the elements in the specified collection to the set, notifying a change for each new element.
+func (o *ObservableSet[T]) AddAll(ts []T) bool {
+	for _, t := range ts {
+		o.Add(t)
+	}
+	return true
+}
+
+// Clear removes all the elements from the set.
+func (o *ObservableSet[T]) Clear() {
+	if o.set.IsEmpty() {
+		return
+	}
+	o.set.Clear()
+	var zero T
+	o.emit(ChangeCleared, zero, zero, zero)
+}
+
+// Contains returns true if the set contains the specified element.
+func (o *ObservableSet[T]) Contains(t T) bool {
+	return o.set.Contains(t)
+}
+
+// IsEmpty returns true if the set contains no elements.
+func (o *ObservableSet[T]) IsEmpty() bool {
+	return o.set.IsEmpty()
+}
+
+// Iterator returns an iterator over the elements in the set.
+func (o *ObservableSet[T]) Iterator() Iterator[T] {
+	return o.set.Iterator()
+}
+
+// Remove removes the specified element from the set, if it is present.
+func (o *ObservableSet[T]) Remove(t T) bool {
+	if !o.set.Remove(t) {
+		return false
+	}
+	var zero T
+	o.emit(ChangeRemoved, t, t, zero)
+	return true
+}
+
+// RemoveIf removes all the elements that satisfy the given predicate, notifying a change for each element.
+func (o *ObservableSet[T]) RemoveIf(f Predicate[T]) bool {
+	removed := false
+	for _, t := range o.set.ToArray() {
+		if f(t) {
+			removed = o.Remove(t) || removed
+		}
+	}
+	return removed
+}
+
+// Size returns the number of elements in the set.
+func (o *ObservableSet[T]) Size() int {
+	return o.set.Size()
+}
+
+// ToArray returns an array containing all the elements in the set.
+func (o *ObservableSet[T]) ToArray() []T {
+	return o.set.ToArray()
+}
+
+// NewObservableMap returns an ObservableMap backed by the map, changes made directly to the map are not notified.
+func NewObservableMap[K comparable, T any](m Map[K, T]) *ObservableMap[K, T] {
+	return &ObservableMap[K, T]{m: m}
+}
+
+// Get returns the value of the key, or ErrKeyNotFound if the key does not exist.
+func (o *ObservableMap[K, T]) Get(key K) (T, error) {
+	return o.m.Get(key)
+}
+
+// Set sets the value of the key, notifying ChangeAdded for a new key and ChangeReplaced for an existing one.
+func (o *ObservableMap[K, T]) Set(key K, value T) {
+	old, err := o.m.Get(key)
+	o.m.Set(key, value)
+	if err != nil {
+		var zero T
+		o.emit(ChangeAdded, key, value, zero)
+		return
+	}
+	o.emit(ChangeReplaced, key, value, old)
+}
+
+// Has returns true if the key exists.
+func (o *ObservableMap[K, T]) Has(key K) bool {
+	return o.m.Has(key)
+}
+
+// Remove removes the key.
+func (o *ObservableMap[K, T]) Remove(key K) {
+	old, err := o.m.Get(key)
+	if err != nil {
+		return
+	}
+	o.m.Remove(key)
+	var zero T
+	o.emit(ChangeRemoved, key, old, zero)
+}
+
+// Clear removes all the keys.
+func (o *ObservableMap[K, T]) Clear() {
+	if o.m.Size() == 0 {
+		return
+	}
+	for _, k := range o.m.Keys() {
+		o.m.Remove(k)
+	}
+	var (
+		key  K
+		zero T
+	)
+	o.emit(ChangeCleared, key, zero, zero)
+}
+
+// Keys returns the keys of the map.
+func (o *ObservableMap[K, T]) Keys() []K {
+	return o.m.Keys()
+}
+
+// Values returns the values of the map.
+func (o *ObservableMap[K, T]) Values() []T {
+	return o.m.Values()
+}
+
+// Size returns the number of entries in the map.
+func (o *ObservableMap[K, T]) Size() int {
+	return o.m.Size()
+}
+
+// Entries returns the key/value pairs of the map.
+func (o *ObservableMap[K, T]) Entries() []*Entry[K, T] {
+	return o.m.Entries()
+}
+
+// Iterator returns an iterator over the entries of the map.
+func (o *ObservableMap[K, T]) Iterator() Iterator[*Entry[K, T]] {
+	return o.m.Iterator()
+}
@@ -0,0 +1,142 @@
+package collections
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"sort"
+	"testing"
+)
+
+func describe[K any, T any](changes []*Change[K, T]) []string {
+	var result []string
+	for _, c := range changes {
+		result = append(result, fmt.Sprintf("%s %v %v %v", c.Type(), c.Key(), c.Value(), c.OldValue()))
+	}
+	return result
+}
+
+func TestObservableList(t *testing.T) {
+	list := NewObservableList[string](NewArrayList[string]())
+	var actual []string
+	list.Subscribe(func(changes []*Change[int, string]) {
+		actual = append(actual, describe(changes)...)
+	})
+
+	list.Add("a")
+	list.AddAt(0, "b")
+	list.Set(1, "c")
+	list.Remove("b")
+	list.AddAll([]string{"d", "e"})
+	list.RemoveIf(func(s string) bool { return s != "d" })
+	list.Clear()
+	list.Clear()
+
+	assert.Equal(t, []string{
+		"added 0 a ",
+		"added 0 b ",
+		"replaced 1 c a",
+		"removed 0 b ",
+		"added 1 d ",
+		"added 2 e ",
+		"removed 0 c ",
+		"removed 1 e ",
+		"cleared 0  ",
+	}, actual)
+}
+
+func TestObservableList_SortedList(t *testing.T) {
+	list := NewObservableList[int](NewSortedListWithElements(compareInt, []int{1, 3}))
+	var actual []string
+	list.Subscribe(func(changes []*Change[int, int]) {
+		actual = append(actual, describe(changes)...)
+	})
+
+	list.Add(2)
+	list.Add(2)
+	list.Add(1)
+
+	assert.Equal(t, []string{"added 1 2 0", "added 2 2 0", "added 1 1 0"}, actual)
+	assert.Equal(t, []int{1, 1, 2, 2, 3}, list.ToArray())
+}
+
+func TestObservableList_Batch(t *testing.T) {
+	list := NewObservableList[int](NewArrayList[int]())
+	var batches [][]string
+	list.Subscribe(func(changes []*Change[int, int]) {
+		batches = append(batches, describe(changes))
+	})
+
+	list.Batch(func() {
+		list.Add(1)
+		list.Batch(func() {
+			list.Add(2)
+		})
+		assert.Empty(t, batches)
+		list.RemoveAt(0)
+	})
+	list.Batch(func() {})
+
+	assert.Equal(t, [][]string{{"added 0 1 0", "added 1 2 0", "removed 0 1 0"}}, batches)
+}
+
+func TestObservableList_Unsubscribe(t *testing.T) {
+	list := NewObservableList[int](NewArrayList[int]())
+	calls := 0
+	unsubscribe := list.Subscribe(func(changes []*Change[int, int]) {
+		calls++
+	})
+
+	list.Add(1)
+	unsubscribe()
+	list.Add(2)
+
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, []int{1, 2}, list.ToArray())
+}
+
+func TestObservableSet(t *testing.T) {
+	set := NewObservableSet[int](NewValueSet[int]())
+	var actual []string
+	set.Subscribe(func(changes []*Change[int, int]) {
+		actual = append(actual, describe(changes)...)
+	})
+
+	set.AddAll([]int{1, 2, 1})
+	set.Remove(3)
+	set.RemoveIf(func(i int) bool { return i == 1 })
+	set.Clear()
+
+	assert.Equal(t, []string{
+		"added 1 1 0",
+		"added 2 2 0",
+		"removed 1 1 0",
+		"cleared 0 0 0",
+	}, actual)
+}
+
+func TestObservableMap(t *testing.T) {
+	m := NewObservableMap[string, int](Dictionary[string, int]{"a": 1})
+	var actual []string
+	m.Subscribe(func(changes []*Change[string, int]) {
+		actual = append(actual, describe(changes)...)
+	})
+
+	m.Set("b", 2)
+	m.Set("a", 3)
+	m.Remove("c")
+	m.Remove("b")
+
+	keys := m.Keys()
+	sort.Strings(keys)
+	assert.Equal(t, []string{"a"}, keys)
+
+	m.Clear()
+
+	assert.Equal(t, []string{
+		"added b 2 0",
+		"replaced a 3 1",
+		"removed b 2 0",
+		"cleared  0 0",
+	}, actual)
+	assert.Equal(t, 0, m.Size())
+}
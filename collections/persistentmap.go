@@ -0,0 +1,391 @@
+package collections
+
+import (
+	"hash/maphash"
+	"math"
+	"math/bits"
+	"reflect"
+)
+
+type (
+
+	// PersistentMap is an immutable map backed by a hash array mapped trie. Updates return a new version of the map
+	// that shares most of its structure with the original, so older versions remain valid and can be read
+	// concurrently without locking.
+	PersistentMap[K comparable, V any] struct {
+		root *hamtNode[K, V]
+		size int
+		hash func(K) uint64
+	}
+
+	hamtNode[K comparable, V any] struct {
+		bitmap   uint32
+		children []hamtChild[K, V]
+	}
+
+	// hamtChild is either a nested node or a leaf holding the entries whose keys share the same hash.
+	hamtChild[K comparable, V any] struct {
+		node *hamtNode[K, V]
+		leaf *hamtLeaf[K, V]
+	}
+
+	hamtLeaf[K comparable, V any] struct {
+		hash    uint64
+		entries []*Entry[K, V]
+	}
+
+	// PersistentKey is a constraint for the keys that a PersistentMap can hash by itself, keys of any other type need
+	// a hash function provided with NewPersistentMapWithHasher.
+	PersistentKey interface {
+		~string | ~bool | ~float32 | ~float64 |
+			~int | ~int8 | ~int16 | ~int32 | ~int64 |
+			~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+	}
+)
+
+const (
+	hamtBits = 5
+	hamtMask = 1<<hamtBits - 1
+)
+
+var hashSeed = maphash.MakeSeed()
+
+// NewPersistentMap returns a new empty PersistentMap whose keys are hashed from their value, use
+// NewPersistentMapWithHasher for keys that are not basic types.
+func NewPersistentMap[K PersistentKey, V any]() *PersistentMap[K, V] {
+	return NewPersistentMapWithHasher[K, V](hashOf[K])
+}
+
+// NewPersistentMapWithHasher returns a new empty PersistentMap that uses the specified hash function, keys that are
+// equal must have the same hash.
+func NewPersistentMapWithHasher[K comparable, V any](hash func(K) uint64) *PersistentMap[K, V] {
+	return &PersistentMap[K, V]{root: &hamtNode[K, V]{}, hash: hash}
+}
+
+// PersistentMapFromDictionary returns a new PersistentMap with the entries of the dictionary.
+func PersistentMapFromDictionary[K PersistentKey, V any](d Dictionary[K, V]) *PersistentMap[K, V] {
+	return PersistentMapFromDictionaryWithHasher(d, hashOf[K])
+}
+
+// PersistentMapFromDictionaryWithHasher returns a new PersistentMap with the entries of the dictionary that uses the
+// specified hash function, keys that are equal must have the same hash.
+func PersistentMapFromDictionaryWithHasher[K comparable, V any](d Dictionary[K, V], hash func(K) uint64) *PersistentMap[K, V] {
+	m := NewPersistentMapWithHasher[K, V](hash)
+	for k, v := range d {
+		m = m.With(k, v)
+	}
+	return m
+}
+
+// Get returns the value of the key in the map or ErrKeyNotFound if the key does not exist.
+func (m *PersistentMap[K, V]) Get(key K) (V, error) {
+	if e := m.root.get(m.hash(key), 0, key); e != nil {
+		return e.value, nil
+	}
+	var zero V
+	return zero, ErrKeyNotFound
+}
+
+// GetOrDefault returns the value of the key in the map or the default value if the key does not exist.
+func (m *PersistentMap[K, V]) GetOrDefault(key K, value V) V {
+	if e := m.root.get(m.hash(key), 0, key); e != nil {
+		return e.value
+	}
+	return value
+}
+
+// Has returns true if the key exists in the map.
+func (m *PersistentMap[K, V]) Has(key K) bool {
+	return m.root.get(m.hash(key), 0, key) != nil
+}
+
+// With returns a new version of the map with the key set to the value.
+func (m *PersistentMap[K, V]) With(key K, value V) *PersistentMap[K, V] {
+	root, added := m.root.with(m.hash(key), 0, &Entry[K, V]{key: key, value: value})
+	size := m.size
+	if added {
+		size++
+	}
+	return &PersistentMap[K, V]{root: root, size: size, hash: m.hash}
+}
+
+// Without returns a new version of the map without the key, or the same map if the key does not exist.
+func (m *PersistentMap[K, V]) Without(key K) *PersistentMap[K, V] {
+	root, removed := m.root.without(m.hash(key), 0, key)
+	if !removed {
+		return m
+	}
+	return &PersistentMap[K, V]{root: root, size: m.size - 1, hash: m.hash}
+}
+
+// Size returns the number of entries in the map.
+func (m *PersistentMap[K, V]) Size() int {
+	return m.size
+}
+
+// IsEmpty returns true if the map contains no entries.
+func (m *PersistentMap[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// Keys returns the keys of the map.
+func (m *PersistentMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.size)
+	m.root.each(func(e *Entry[K, V]) {
+		keys = append(keys, e.key)
+	})
+	return keys
+}
+
+// Values returns the values of the map.
+func (m *PersistentMap[K, V]) Values() []V {
+	values := make([]V, 0, m.size)
+	m.root.each(func(e *Entry[K, V]) {
+		values = append(values, e.value)
+	})
+	return values
+}
+
+// Entries returns the key/value pairs of the map.
+func (m *PersistentMap[K, V]) Entries() []*Entry[K, V] {
+	entries := make([]*Entry[K, V], 0, m.size)
+	m.root.each(func(e *Entry[K, V]) {
+		entries = append(entries, &Entry[K, V]{key: e.key, value: e.value})
+	})
+	return entries
+}
+
+// ToDictionary returns a new dictionary with the entries of the map.
+func (m *PersistentMap[K, V]) ToDictionary() Dictionary[K, V] {
+	d := make(Dictionary[K, V], m.size)
+	m.root.each(func(e *Entry[K, V]) {
+		d[e.key] = e.value
+	})
+	return d
+}
+
+// Iterator returns an iterator over the entries of the map.
+func (m *PersistentMap[K, V]) Iterator() Iterator[*Entry[K, V]] {
+	return IteratorFromSlice(m.Entries())
+}
+
+func (n *hamtNode[K, V]) position(hash uint64, shift uint) (uint32, int) {
+	bit := uint32(1) << ((hash >> shift) & hamtMask)
+	return bit, bits.OnesCount32(n.bitmap & (bit - 1))
+}
+
+func (n *hamtNode[K, V]) get(hash uint64, shift uint, key K) *Entry[K, V] {
+	for {
+		bit, i := n.position(hash, shift)
+		if n.bitmap&bit == 0 {
+			return nil
+		}
+		child := n.children[i]
+		if child.leaf != nil {
+			return child.leaf.find(hash, key)
+		}
+		n = child.node
+		shift += hamtBits
+	}
+}
+
+func (n *hamtNode[K, V]) with(hash uint64, shift uint, e *Entry[K, V]) (*hamtNode[K, V], bool) {
+	bit, i := n.position(hash, shift)
+	if n.bitmap&bit == 0 {
+		children := make([]hamtChild[K, V], len(n.children)+1)
+		copy(children, n.children[:i])
+		children[i] = hamtChild[K, V]{leaf: &hamtLeaf[K, V]{hash: hash, entries: []*Entry[K, V]{e}}}
+		copy(children[i+1:], n.children[i:])
+		return &hamtNode[K, V]{bitmap: n.bitmap | bit, children: children}, true
+	}
+
+	var child hamtChild[K, V]
+	added := false
+	switch current := n.children[i]; {
+	case current.node != nil:
+		var node *hamtNode[K, V]
+		node, added = current.node.with(hash, shift+hamtBits, e)
+		child = hamtChild[K, V]{node: node}
+	case current.leaf.hash == hash:
+		var leaf *hamtLeaf[K, V]
+		leaf, added = current.leaf.with(e)
+		child = hamtChild[K, V]{leaf: leaf}
+	default:
+		leaf := &hamtLeaf[K, V]{hash: hash, entries: []*Entry[K, V]{e}}
+		child = hamtChild[K, V]{node: mergeLeaves(current.leaf, leaf, shift+hamtBits)}
+		added = true
+	}
+
+	return n.replace(i, child), added
+}
+
+func (n *hamtNode[K, V]) without(hash uint64, shift uint, key K) (*hamtNode[K, V], bool) {
+	bit, i := n.position(hash, shift)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+
+	current := n.children[i]
+	if current.node != nil {
+		node, removed := current.node.without(hash, shift+hamtBits, key)
+		if !removed {
+			return n, false
+		}
+		switch {
+		case node.bitmap == 0:
+			return n.remove(i, bit), true
+		case len(node.children) == 1 && node.children[0].leaf != nil:
+			return n.replace(i, node.children[0]), true
+		}
+		return n.replace(i, hamtChild[K, V]{node: node}), true
+	}
+
+	if current.leaf.hash != hash {
+		return n, false
+	}
+	leaf, removed := current.leaf.without(key)
+	if !removed {
+		return n, false
+	}
+	if leaf == nil {
+		return n.remove(i, bit), true
+	}
+	return n.replace(i, hamtChild[K, V]{leaf: leaf}), true
+}
+
+func (n *hamtNode[K, V]) replace(i int, child hamtChild[K, V]) *hamtNode[K, V] {
+	children := make([]hamtChild[K, V], len(n.children))
+	copy(children, n.children)
+	children[i] = child
+	return &hamtNode[K, V]{bitmap: n.bitmap, children: children}
+}
+
+func (n *hamtNode[K, V]) remove(i int, bit uint32) *hamtNode[K, V] {
+	children := make([]hamtChild[K, V], 0, len(n.children)-1)
+	children = append(children, n.children[:i]...)
+	children = append(children, n.children[i+1:]...)
+	return &hamtNode[K, V]{bitmap: n.bitmap &^ bit, children: children}
+}
+
+func (n *hamtNode[K, V]) each(fn func(*Entry[K, V])) {
+	for _, child := range n.children {
+		if child.node != nil {
+			child.node.each(fn)
+			continue
+		}
+		for _, e := range child.leaf.entries {
+			fn(e)
+		}
+	}
+}
+
+// mergeLeaves returns a node holding two leaves with different hashes, nesting as many levels as the hashes share.
+func mergeLeaves[K comparable, V any](a, b *hamtLeaf[K, V], shift uint) *hamtNode[K, V] {
+	ia, ib := (a.hash>>shift)&hamtMask, (b.hash>>shift)&hamtMask
+	if ia == ib {
+		return &hamtNode[K, V]{
+			bitmap:   1 << ia,
+			children: []hamtChild[K, V]{{node: mergeLeaves(a, b, shift+hamtBits)}},
+		}
+	}
+	if ia > ib {
+		a, b, ia, ib = b, a, ib, ia
+	}
+	return &hamtNode[K, V]{
+		bitmap:   1<<ia | 1<<ib,
+		children: []hamtChild[K, V]{{leaf: a}, {leaf: b}},
+	}
+}
+
+func (l *hamtLeaf[K, V]) find(hash uint64, key K) *Entry[K, V] {
+	if l.hash != hash {
+		return nil
+	}
+	for _, e := range l.entries {
+		if e.key == key {
+			return e
+		}
+	}
+	return nil
+}
+
+func (l *hamtLeaf[K, V]) with(e *Entry[K, V]) (*hamtLeaf[K, V], bool) {
+	entries := make([]*Entry[K, V], len(l.entries), len(l.entries)+1)
+	copy(entries, l.entries)
+	for i, current := range entries {
+		if current.key == e.key {
+			entries[i] = e
+			return &hamtLeaf[K, V]{hash: l.hash, entries: entries}, false
+		}
+	}
+	return &hamtLeaf[K, V]{hash: l.hash, entries: append(entries, e)}, true
+}
+
+// without returns the leaf without the key, or nil if the leaf becomes empty.
+func (l *hamtLeaf[K, V]) without(key K) (*hamtLeaf[K, V], bool) {
+	for i, e := range l.entries {
+		if e.key != key {
+			continue
+		}
+		if len(l.entries) == 1 {
+			return nil, true
+		}
+		entries := make([]*Entry[K, V], 0, len(l.entries)-1)
+		entries = append(entries, l.entries[:i]...)
+		entries = append(entries, l.entries[i+1:]...)
+		return &hamtLeaf[K, V]{hash: l.hash, entries: entries}, true
+	}
+	return l, false
+}
+
+// hashOf returns the hash of a key from its value, the common types are matched directly and the types defined over
+// them through their kind.
+func hashOf[K PersistentKey](key K) uint64 {
+	switch k := any(key).(type) {
+	case string:
+		return maphash.String(hashSeed, k)
+	case int:
+		return mix64(uint64(k))
+	case int64:
+		return mix64(uint64(k))
+	case uint64:
+		return mix64(k)
+	case float64:
+		return hashFloat(k)
+	}
+
+	v := reflect.ValueOf(key)
+	switch v.Kind() {
+	case reflect.String:
+		return maphash.String(hashSeed, v.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return mix64(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return mix64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return hashFloat(v.Float())
+	case reflect.Bool:
+		if v.Bool() {
+			return mix64(1)
+		}
+	}
+	return mix64(0)
+}
+
+func hashFloat(f float64) uint64 {
+	if f == 0 {
+		// +0 and -0 are equal keys.
+		f = 0
+	}
+	return mix64(math.Float64bits(f))
+}
+
+// mix64 is the splitmix64 finalizer, it spreads the bits of integer keys across the whole hash.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
@@ -0,0 +1,128 @@
+package collections
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+type point struct {
+	x, y int
+}
+
+func TestPersistentMap_With(t *testing.T) {
+	empty := NewPersistentMap[string, int]()
+	m1 := empty.With("a", 1)
+	m2 := m1.With("b", 2)
+	m3 := m2.With("a", 3)
+
+	assert.Equal(t, 0, empty.Size())
+	assert.Equal(t, Dictionary[string, int]{"a": 1}, m1.ToDictionary())
+	assert.Equal(t, Dictionary[string, int]{"a": 1, "b": 2}, m2.ToDictionary())
+	assert.Equal(t, Dictionary[string, int]{"a": 3, "b": 2}, m3.ToDictionary())
+	assert.Equal(t, 2, m3.Size())
+}
+
+func TestPersistentMap_Get(t *testing.T) {
+	m := PersistentMapFromDictionary(Dictionary[string, int]{"a": 1})
+
+	v, err := m.Get("a")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, v)
+
+	_, err = m.Get("b")
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+	assert.Equal(t, 2, m.GetOrDefault("b", 2))
+	assert.True(t, m.Has("a"))
+	assert.False(t, m.Has("b"))
+}
+
+func TestPersistentMap_Without(t *testing.T) {
+	m1 := PersistentMapFromDictionary(Dictionary[string, int]{"a": 1, "b": 2})
+	m2 := m1.Without("a")
+
+	assert.Equal(t, Dictionary[string, int]{"a": 1, "b": 2}, m1.ToDictionary())
+	assert.Equal(t, Dictionary[string, int]{"b": 2}, m2.ToDictionary())
+	assert.Same(t, m2, m2.Without("a"))
+}
+
+func TestPersistentMap_Collisions(t *testing.T) {
+	// A constant hash forces every key into the same leaf.
+	m := NewPersistentMapWithHasher[int, int](func(int) uint64 { return 42 })
+	for i := 0; i < 10; i++ {
+		m = m.With(i, i*10)
+	}
+	m = m.Without(3).With(5, 0)
+
+	assert.Equal(t, 9, m.Size())
+	assert.False(t, m.Has(3))
+	assert.Equal(t, 0, m.GetOrDefault(5, -1))
+	assert.Equal(t, 90, m.GetOrDefault(9, -1))
+}
+
+func TestPersistentMap_Large(t *testing.T) {
+	const n = 5000
+	expected := Dictionary[int, string]{}
+	m := NewPersistentMap[int, string]()
+	for i := 0; i < n; i++ {
+		m = m.With(i, strconv.Itoa(i))
+		expected[i] = strconv.Itoa(i)
+	}
+	snapshot := m
+	for i := 0; i < n; i += 2 {
+		m = m.Without(i)
+		delete(expected, i)
+	}
+
+	assert.Equal(t, n, snapshot.Size())
+	assert.Equal(t, n/2, m.Size())
+	assert.Equal(t, expected, m.ToDictionary())
+	for i := 0; i < n; i++ {
+		assert.True(t, snapshot.Has(i))
+	}
+}
+
+func TestPersistentMap_StructKeys(t *testing.T) {
+	hash := func(p point) uint64 {
+		return mix64(uint64(p.x)<<32 | uint64(uint32(p.y)))
+	}
+	m := NewPersistentMapWithHasher[point, string](hash).With(point{1, 2}, "a").With(point{2, 1}, "b")
+
+	assert.Equal(t, "a", m.GetOrDefault(point{1, 2}, ""))
+	assert.Equal(t, "b", m.GetOrDefault(point{2, 1}, ""))
+
+	m = PersistentMapFromDictionaryWithHasher(Dictionary[point, string]{{1, 2}: "a"}, hash)
+	assert.Equal(t, "a", m.GetOrDefault(point{1, 2}, ""))
+}
+
+func TestPersistentMap_DefinedKeys(t *testing.T) {
+	type id string
+	type level uint8
+
+	ids := NewPersistentMap[id, int]().With("a", 1).With("b", 2).With("a", 3)
+	assert.Equal(t, 2, ids.Size())
+	assert.Equal(t, 3, ids.GetOrDefault("a", 0))
+
+	levels := NewPersistentMap[level, bool]().With(1, true).With(2, false)
+	assert.True(t, levels.Has(1))
+	assert.False(t, levels.Has(3))
+
+	zero := math.Copysign(0, -1)
+	floats := NewPersistentMap[float64, string]().With(0, "positive").With(zero, "negative")
+	assert.Equal(t, 1, floats.Size())
+	assert.Equal(t, "negative", floats.GetOrDefault(0, ""))
+}
+
+func TestPersistentMap_Iterator(t *testing.T) {
+	m := PersistentMapFromDictionary(Dictionary[string, int]{"a": 1, "b": 2})
+
+	var keys []string
+	for it := m.Iterator(); it.HasNext(); {
+		keys = append(keys, it.Next().Key())
+	}
+	sort.Strings(keys)
+
+	assert.Equal(t, []string{"a", "b"}, keys)
+}
@@ -0,0 +1,213 @@
+package collections
+
+type (
+
+	// PersistentVector is an immutable indexed sequence backed by a 32-way trie with a tail buffer. Updates return a
+	// new version of the vector in O(log n) that shares most of its structure with the original, so older versions
+	// remain valid and can be read concurrently without locking.
+	PersistentVector[T any] struct {
+		size  int
+		shift uint
+		root  *vectorNode[T]
+		tail  []T
+	}
+
+	// vectorNode is an inner node of the trie when children is set, or a leaf holding up to 32 values.
+	vectorNode[T any] struct {
+		children []*vectorNode[T]
+		values   []T
+	}
+)
+
+const (
+	vectorBits  = 5
+	vectorWidth = 1 << vectorBits
+	vectorMask  = vectorWidth - 1
+)
+
+// NewPersistentVector returns a new empty PersistentVector.
+func NewPersistentVector[T any]() *PersistentVector[T] {
+	return &PersistentVector[T]{shift: vectorBits, root: &vectorNode[T]{}}
+}
+
+// NewPersistentVectorWithElements returns a new PersistentVector with the specified elements.
+func NewPersistentVectorWithElements[T any](elements []T) *PersistentVector[T] {
+	v := NewPersistentVector[T]()
+	for _, e := range elements {
+		v = v.Append(e)
+	}
+	return v
+}
+
+// Size returns the number of elements in the vector.
+func (v *PersistentVector[T]) Size() int {
+	return v.size
+}
+
+// IsEmpty returns true if the vector contains no elements.
+func (v *PersistentVector[T]) IsEmpty() bool {
+	return v.size == 0
+}
+
+// Get returns the element at the specified position in the vector, it panics if the position is out of range.
+func (v *PersistentVector[T]) Get(i int) T {
+	return v.valuesFor(i)[i&vectorMask]
+}
+
+// Append returns a new version of the vector with the element added at the end.
+func (v *PersistentVector[T]) Append(t T) *PersistentVector[T] {
+	if v.size-v.tailOffset() < vectorWidth {
+		tail := make([]T, len(v.tail), len(v.tail)+1)
+		copy(tail, v.tail)
+		return &PersistentVector[T]{size: v.size + 1, shift: v.shift, root: v.root, tail: append(tail, t)}
+	}
+
+	tailNode := &vectorNode[T]{values: v.tail}
+	root, shift := v.root, v.shift
+	if v.size>>vectorBits > 1<<v.shift {
+		root = &vectorNode[T]{children: []*vectorNode[T]{v.root, newVectorPath(v.shift, tailNode)}}
+		shift += vectorBits
+	} else {
+		root = v.pushTail(v.shift, v.root, tailNode)
+	}
+	return &PersistentVector[T]{size: v.size + 1, shift: shift, root: root, tail: []T{t}}
+}
+
+// With returns a new version of the vector with the element at the specified position replaced, it panics if the
+// position is out of range.
+func (v *PersistentVector[T]) With(i int, t T) *PersistentVector[T] {
+	if i < 0 || i >= v.size {
+		panic(ErrIndexOutOfBounds)
+	}
+	if i >= v.tailOffset() {
+		tail := make([]T, len(v.tail))
+		copy(tail, v.tail)
+		tail[i&vectorMask] = t
+		return &PersistentVector[T]{size: v.size, shift: v.shift, root: v.root, tail: tail}
+	}
+	return &PersistentVector[T]{size: v.size, shift: v.shift, root: assocVector(v.shift, v.root, i, t), tail: v.tail}
+}
+
+// Pop returns a new version of the vector without its last element, it panics if the vector is empty.
+func (v *PersistentVector[T]) Pop() *PersistentVector[T] {
+	switch {
+	case v.size == 0:
+		panic(ErrIndexOutOfBounds)
+	case v.size == 1:
+		return NewPersistentVector[T]()
+	case v.size-v.tailOffset() > 1:
+		last := len(v.tail) - 1
+		return &PersistentVector[T]{size: v.size - 1, shift: v.shift, root: v.root, tail: v.tail[:last:last]}
+	}
+
+	tail := v.valuesFor(v.size - 2)
+	root, shift := v.popTail(v.shift, v.root), v.shift
+	if root == nil {
+		root = &vectorNode[T]{}
+	}
+	if shift > vectorBits && len(root.children) == 1 {
+		root = root.children[0]
+		shift -= vectorBits
+	}
+	return &PersistentVector[T]{size: v.size - 1, shift: shift, root: root, tail: tail}
+}
+
+// ToArray returns an array containing all the elements in the vector in proper sequence.
+func (v *PersistentVector[T]) ToArray() []T {
+	array := make([]T, 0, v.size)
+	for i := 0; i < v.size; i += vectorWidth {
+		array = append(array, v.valuesFor(i)...)
+	}
+	return array
+}
+
+// Iterator returns an iterator over the elements in the vector in proper sequence.
+func (v *PersistentVector[T]) Iterator() Iterator[T] {
+	return IteratorFromSlice(v.ToArray())
+}
+
+func (v *PersistentVector[T]) tailOffset() int {
+	if v.size < vectorWidth {
+		return 0
+	}
+	return ((v.size - 1) >> vectorBits) << vectorBits
+}
+
+func (v *PersistentVector[T]) valuesFor(i int) []T {
+	if i < 0 || i >= v.size {
+		panic(ErrIndexOutOfBounds)
+	}
+	if i >= v.tailOffset() {
+		return v.tail
+	}
+	node := v.root
+	for level := v.shift; level > 0; level -= vectorBits {
+		node = node.children[(i>>level)&vectorMask]
+	}
+	return node.values
+}
+
+func (v *PersistentVector[T]) pushTail(level uint, parent, tailNode *vectorNode[T]) *vectorNode[T] {
+	i := ((v.size - 1) >> level) & vectorMask
+	node := tailNode
+	if level > vectorBits {
+		if i < len(parent.children) {
+			node = v.pushTail(level-vectorBits, parent.children[i], tailNode)
+		} else {
+			node = newVectorPath(level-vectorBits, tailNode)
+		}
+	}
+
+	children := make([]*vectorNode[T], len(parent.children), len(parent.children)+1)
+	copy(children, parent.children)
+	if i < len(children) {
+		children[i] = node
+	} else {
+		children = append(children, node)
+	}
+	return &vectorNode[T]{children: children}
+}
+
+// popTail returns the node without the leaf holding the last elements of the trie, or nil if the node becomes empty.
+func (v *PersistentVector[T]) popTail(level uint, node *vectorNode[T]) *vectorNode[T] {
+	i := ((v.size - 2) >> level) & vectorMask
+	if level > vectorBits {
+		child := v.popTail(level-vectorBits, node.children[i])
+		if child == nil && i == 0 {
+			return nil
+		}
+		children := make([]*vectorNode[T], i, i+1)
+		copy(children, node.children[:i])
+		if child != nil {
+			children = append(children, child)
+		}
+		return &vectorNode[T]{children: children}
+	}
+	if i == 0 {
+		return nil
+	}
+	children := make([]*vectorNode[T], i)
+	copy(children, node.children[:i])
+	return &vectorNode[T]{children: children}
+}
+
+func newVectorPath[T any](level uint, node *vectorNode[T]) *vectorNode[T] {
+	if level == 0 {
+		return node
+	}
+	return &vectorNode[T]{children: []*vectorNode[T]{newVectorPath(level-vectorBits, node)}}
+}
+
+func assocVector[T any](level uint, node *vectorNode[T], i int, t T) *vectorNode[T] {
+	if level == 0 {
+		values := make([]T, len(node.values))
+		copy(values, node.values)
+		values[i&vectorMask] = t
+		return &vectorNode[T]{values: values}
+	}
+	children := make([]*vectorNode[T], len(node.children))
+	copy(children, node.children)
+	j := (i >> level) & vectorMask
+	children[j] = assocVector(level-vectorBits, node.children[j], i, t)
+	return &vectorNode[T]{children: children}
+}
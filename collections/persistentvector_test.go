@@ -0,0 +1,83 @@
+package collections
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPersistentVector_Append(t *testing.T) {
+	empty := NewPersistentVector[int]()
+	v1 := empty.Append(1)
+	v2 := v1.Append(2)
+
+	assert.Equal(t, 0, empty.Size())
+	assert.Equal(t, []int{1}, v1.ToArray())
+	assert.Equal(t, []int{1, 2}, v2.ToArray())
+}
+
+func TestPersistentVector_Large(t *testing.T) {
+	const n = 40000
+	expected := make([]int, n)
+	v := NewPersistentVector[int]()
+	for i := 0; i < n; i++ {
+		v = v.Append(i)
+		expected[i] = i
+	}
+
+	assert.Equal(t, n, v.Size())
+	assert.Equal(t, expected, v.ToArray())
+	for _, i := range []int{0, 31, 32, 1023, 1024, 32767, 32768, n - 1} {
+		assert.Equal(t, i, v.Get(i))
+	}
+}
+
+func TestPersistentVector_With(t *testing.T) {
+	v1 := NewPersistentVectorWithElements(make([]int, 100))
+	v2 := v1.With(10, 1).With(99, 2)
+
+	assert.Equal(t, 0, v1.Get(10))
+	assert.Equal(t, 0, v1.Get(99))
+	assert.Equal(t, 1, v2.Get(10))
+	assert.Equal(t, 2, v2.Get(99))
+	assert.PanicsWithValue(t, ErrIndexOutOfBounds, func() { v1.With(100, 1) })
+}
+
+func TestPersistentVector_Pop(t *testing.T) {
+	const n = 2000
+	v := NewPersistentVector[int]()
+	for i := 0; i < n; i++ {
+		v = v.Append(i)
+	}
+	snapshot := v
+
+	for i := n - 1; i >= 0; i-- {
+		assert.Equal(t, i, v.Get(v.Size()-1))
+		v = v.Pop()
+		assert.Equal(t, i, v.Size())
+	}
+
+	assert.True(t, v.IsEmpty())
+	assert.Equal(t, n, snapshot.Size())
+	assert.Equal(t, n-1, snapshot.Get(n-1))
+	assert.PanicsWithValue(t, ErrIndexOutOfBounds, func() { v.Pop() })
+}
+
+func TestPersistentVector_PopThenAppend(t *testing.T) {
+	v := NewPersistentVectorWithElements(make([]int, 1057))
+	v = v.Pop().Pop().Append(1).Append(2).Append(3)
+
+	assert.Equal(t, 1058, v.Size())
+	assert.Equal(t, 3, v.Get(1057))
+	assert.Equal(t, 1, v.Get(1055))
+}
+
+func TestPersistentVector_Iterator(t *testing.T) {
+	v := NewPersistentVectorWithElements([]int{1, 2, 3})
+
+	var actual int
+	for it := v.Iterator(); it.HasNext(); {
+		actual += it.Next()
+	}
+	assert.Equal(t, 6, actual)
+	assert.PanicsWithValue(t, ErrIndexOutOfBounds, func() { v.Get(3) })
+}
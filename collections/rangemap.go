@@ -0,0 +1,155 @@
+package collections
+
+import (
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+type (
+
+	// RangeMap assigns values to non-overlapping half-open ranges [low, high). Putting a range splits or replaces
+	// the ranges it overlaps, and the entries are iterated in order of their ranges.
+	RangeMap[K constraints.Ordered, V any] struct {
+		entries []*Entry[Interval[K], V]
+	}
+)
+
+var _ Iterable[*Entry[Interval[int], any]] = (*RangeMap[int, any])(nil)
+
+// NewRangeMap returns a new empty RangeMap.
+func NewRangeMap[K constraints.Ordered, V any]() *RangeMap[K, V] {
+	return &RangeMap[K, V]{}
+}
+
+// Put assigns the value to the range [low, high), the overlapped parts of existing ranges are replaced. It panics with
+// ErrInvalidInterval if low is greater than high.
+func (m *RangeMap[K, V]) Put(low, high K, value V) {
+	interval := NewHalfOpenInterval(low, high)
+	if interval.IsEmpty() {
+		return
+	}
+	i := m.cut(interval)
+	m.entries = insertAt(m.entries, i, &Entry[Interval[K], V]{key: interval, value: value})
+}
+
+// Remove removes the range [low, high), splitting the ranges it partially overlaps. It panics with
+// ErrInvalidInterval if low is greater than high.
+func (m *RangeMap[K, V]) Remove(low, high K) {
+	if interval := NewHalfOpenInterval(low, high); !interval.IsEmpty() {
+		m.cut(interval)
+	}
+}
+
+// Get returns the value of the range containing the key, or ErrKeyNotFound if no range contains it.
+func (m *RangeMap[K, V]) Get(k K) (V, error) {
+	if e := m.find(k); e != nil {
+		return e.value, nil
+	}
+	var zero V
+	return zero, ErrKeyNotFound
+}
+
+// GetOrDefault returns the value of the range containing the key or the default value if no range contains it.
+func (m *RangeMap[K, V]) GetOrDefault(k K, value V) V {
+	if e := m.find(k); e != nil {
+		return e.value
+	}
+	return value
+}
+
+// GetEntry returns the range containing the key along with its value, or ErrKeyNotFound if no range contains it.
+func (m *RangeMap[K, V]) GetEntry(k K) (*Entry[Interval[K], V], error) {
+	if e := m.find(k); e != nil {
+		return e, nil
+	}
+	return nil, ErrKeyNotFound
+}
+
+// Has returns true if a range contains the key.
+func (m *RangeMap[K, V]) Has(k K) bool {
+	return m.find(k) != nil
+}
+
+// Size returns the number of ranges in the map.
+func (m *RangeMap[K, V]) Size() int {
+	return len(m.entries)
+}
+
+// IsEmpty returns true if the map contains no ranges.
+func (m *RangeMap[K, V]) IsEmpty() bool {
+	return len(m.entries) == 0
+}
+
+// Clear removes all the ranges.
+func (m *RangeMap[K, V]) Clear() {
+	m.entries = nil
+}
+
+// Ranges returns the ranges of the map in order.
+func (m *RangeMap[K, V]) Ranges() []Interval[K] {
+	ranges := make([]Interval[K], len(m.entries))
+	for i, e := range m.entries {
+		ranges[i] = e.key
+	}
+	return ranges
+}
+
+// Entries returns the range/value pairs of the map in order of their ranges.
+func (m *RangeMap[K, V]) Entries() []*Entry[Interval[K], V] {
+	entries := make([]*Entry[Interval[K], V], len(m.entries))
+	for i, e := range m.entries {
+		entries[i] = &Entry[Interval[K], V]{key: e.key, value: e.value}
+	}
+	return entries
+}
+
+// Iterator returns an iterator over the entries of the map in order of their ranges.
+func (m *RangeMap[K, V]) Iterator() Iterator[*Entry[Interval[K], V]] {
+	return IteratorFromSlice(m.Entries())
+}
+
+// find returns the entry of the range containing the key, or nil if there is none.
+func (m *RangeMap[K, V]) find(k K) *Entry[Interval[K], V] {
+	i := sort.Search(len(m.entries), func(i int) bool {
+		return k < m.entries[i].key.high
+	})
+	if i < len(m.entries) && m.entries[i].key.low <= k {
+		return m.entries[i]
+	}
+	return nil
+}
+
+// cut removes the interval from the ranges, keeping the parts of the overlapped ranges outside of it, and returns the
+// position where the interval belongs.
+func (m *RangeMap[K, V]) cut(interval Interval[K]) int {
+	i := sort.Search(len(m.entries), func(i int) bool {
+		return interval.low < m.entries[i].key.high
+	})
+	j := i
+	for j < len(m.entries) && m.entries[j].key.low < interval.high {
+		j++
+	}
+	if i == j {
+		return i
+	}
+
+	var remnants []*Entry[Interval[K], V]
+	if first := m.entries[i]; first.key.low < interval.low {
+		remnants = append(remnants, &Entry[Interval[K], V]{key: NewHalfOpenInterval(first.key.low, interval.low), value: first.value})
+	}
+	if last := m.entries[j-1]; interval.high < last.key.high {
+		remnants = append(remnants, &Entry[Interval[K], V]{key: NewHalfOpenInterval(interval.high, last.key.high), value: last.value})
+	}
+
+	entries := make([]*Entry[Interval[K], V], 0, len(m.entries)-(j-i)+len(remnants))
+	entries = append(entries, m.entries[:i]...)
+	entries = append(entries, remnants...)
+	entries = append(entries, m.entries[j:]...)
+	m.entries = entries
+
+	if len(remnants) > 0 && remnants[0].key.low < interval.low {
+		return i + 1
+	}
+	return i
+}
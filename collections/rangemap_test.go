@@ -0,0 +1,117 @@
+package collections
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRangeMap_Put(t *testing.T) {
+	tests := []struct {
+		name     string
+		low      int
+		high     int
+		expected []*Entry[Interval[int], string]
+	}{
+		{
+			name: "Split inside",
+			low:  3,
+			high: 5,
+			expected: []*Entry[Interval[int], string]{
+				NewEntry(NewHalfOpenInterval(0, 3), "a"),
+				NewEntry(NewHalfOpenInterval(3, 5), "x"),
+				NewEntry(NewHalfOpenInterval(5, 10), "a"),
+				NewEntry(NewHalfOpenInterval(10, 20), "b"),
+			},
+		},
+		{
+			name: "Across ranges",
+			low:  5,
+			high: 15,
+			expected: []*Entry[Interval[int], string]{
+				NewEntry(NewHalfOpenInterval(0, 5), "a"),
+				NewEntry(NewHalfOpenInterval(5, 15), "x"),
+				NewEntry(NewHalfOpenInterval(15, 20), "b"),
+			},
+		},
+		{
+			name: "Replace all",
+			low:  -5,
+			high: 25,
+			expected: []*Entry[Interval[int], string]{
+				NewEntry(NewHalfOpenInterval(-5, 25), "x"),
+			},
+		},
+		{
+			name: "Exact range",
+			low:  10,
+			high: 20,
+			expected: []*Entry[Interval[int], string]{
+				NewEntry(NewHalfOpenInterval(0, 10), "a"),
+				NewEntry(NewHalfOpenInterval(10, 20), "x"),
+			},
+		},
+		{
+			name: "After",
+			low:  30,
+			high: 40,
+			expected: []*Entry[Interval[int], string]{
+				NewEntry(NewHalfOpenInterval(0, 10), "a"),
+				NewEntry(NewHalfOpenInterval(10, 20), "b"),
+				NewEntry(NewHalfOpenInterval(30, 40), "x"),
+			},
+		},
+		{
+			name: "Empty range",
+			low:  5,
+			high: 5,
+			expected: []*Entry[Interval[int], string]{
+				NewEntry(NewHalfOpenInterval(0, 10), "a"),
+				NewEntry(NewHalfOpenInterval(10, 20), "b"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewRangeMap[int, string]()
+			m.Put(0, 10, "a")
+			m.Put(10, 20, "b")
+			m.Put(tt.low, tt.high, "x")
+			assert.Equal(t, tt.expected, m.Entries())
+		})
+	}
+}
+
+func TestRangeMap_Get(t *testing.T) {
+	m := NewRangeMap[float64, string]()
+	m.Put(0, 10, "basic")
+	m.Put(10, 100, "standard")
+	m.Put(500, 1000, "premium")
+
+	v, err := m.Get(10)
+	assert.NoError(t, err)
+	assert.Equal(t, "standard", v)
+
+	_, err = m.Get(100)
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+	assert.Equal(t, "none", m.GetOrDefault(-1, "none"))
+	assert.True(t, m.Has(999.5))
+	assert.False(t, m.Has(1000))
+
+	e, err := m.GetEntry(750)
+	assert.NoError(t, err)
+	assert.Equal(t, NewHalfOpenInterval[float64](500, 1000), e.Key())
+}
+
+func TestRangeMap_Remove(t *testing.T) {
+	m := NewRangeMap[int, string]()
+	m.Put(0, 10, "a")
+	m.Put(10, 20, "b")
+
+	m.Remove(5, 12)
+	assert.Equal(t, []Interval[int]{NewHalfOpenInterval(0, 5), NewHalfOpenInterval(12, 20)}, m.Ranges())
+	assert.Equal(t, 2, m.Size())
+
+	m.Clear()
+	assert.True(t, m.IsEmpty())
+}
@@ -0,0 +1,209 @@
+package collections
+
+import (
+	"errors"
+
+	"golang.org/x/exp/constraints"
+)
+
+type (
+
+	// SegmentTree is a tree over a fixed number of elements that aggregates ranges of elements with an associative
+	// combine function, such as SegmentSum, SegmentMin, SegmentMax or SegmentGCD, in logarithmic time. Assigning a
+	// value to a range is applied lazily so it is as cheap as a query. Range updates only assign, adding a value to a
+	// range is not supported as its effect on the aggregate depends on the combine function, a FenwickTree supports it
+	// for sums. The ranges are half-open [from, to).
+	SegmentTree[T any] struct {
+		size    int
+		combine func(a, b T) T
+		// nodes holds the aggregate of every node, the children of node i are 2i+1 and 2i+2.
+		nodes []T
+		// pending holds the value assigned to the range of a node that was not yet pushed to its children.
+		pending    []T
+		hasPending []bool
+	}
+)
+
+var (
+	ErrEmptyRange = errors.New("empty range")
+)
+
+// SegmentSum returns the sum of both numbers, it is a combine function for a SegmentTree.
+func SegmentSum[T Number](a, b T) T {
+	return a + b
+}
+
+// SegmentMin returns the smallest of both values, it is a combine function for a SegmentTree.
+func SegmentMin[T constraints.Ordered](a, b T) T {
+	if b < a {
+		return b
+	}
+	return a
+}
+
+// SegmentMax returns the largest of both values, it is a combine function for a SegmentTree.
+func SegmentMax[T constraints.Ordered](a, b T) T {
+	if b > a {
+		return b
+	}
+	return a
+}
+
+// SegmentGCD returns the greatest common divisor of both integers, it is a combine function for a SegmentTree.
+func SegmentGCD[T constraints.Integer](a, b T) T {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// NewSegmentTree returns a new SegmentTree with the specified elements aggregated by the combine function, which
+// must be associative.
+func NewSegmentTree[T any](elements []T, combine func(a, b T) T) *SegmentTree[T] {
+	s := &SegmentTree[T]{
+		size:       len(elements),
+		combine:    combine,
+		nodes:      make([]T, 4*len(elements)),
+		pending:    make([]T, 4*len(elements)),
+		hasPending: make([]bool, 4*len(elements)),
+	}
+	if s.size > 0 {
+		s.build(0, 0, s.size, elements)
+	}
+	return s
+}
+
+// Size returns the number of elements in the tree.
+func (s *SegmentTree[T]) Size() int {
+	return s.size
+}
+
+// Get returns the element at the specified position, it panics with ErrIndexOutOfBounds if the position is out of
+// range.
+func (s *SegmentTree[T]) Get(i int) T {
+	return s.Query(i, i+1)
+}
+
+// Set replaces the element at the specified position, it panics with ErrIndexOutOfBounds if the position is out of
+// range.
+func (s *SegmentTree[T]) Set(i int, t T) {
+	s.SetRange(i, i+1, t)
+}
+
+// SetRange replaces every element in [from, to) with the value, rather than adding to them, it panics with
+// ErrIndexOutOfBounds if the range is out of bounds.
+func (s *SegmentTree[T]) SetRange(from, to int, t T) {
+	s.checkRange(from, to)
+	if from < to {
+		s.assign(0, 0, s.size, from, to, t)
+	}
+}
+
+// Query returns the aggregate of the elements in [from, to), it panics with ErrIndexOutOfBounds if the range is out
+// of bounds and with ErrEmptyRange if the range is empty.
+func (s *SegmentTree[T]) Query(from, to int) T {
+	s.checkRange(from, to)
+	if from == to {
+		panic(ErrEmptyRange)
+	}
+	return s.query(0, 0, s.size, from, to)
+}
+
+// ToArray returns an array containing all the elements in the tree.
+func (s *SegmentTree[T]) ToArray() []T {
+	array := make([]T, s.size)
+	for i := range array {
+		array[i] = s.query(0, 0, s.size, i, i+1)
+	}
+	return array
+}
+
+func (s *SegmentTree[T]) build(node, low, high int, elements []T) {
+	if high-low == 1 {
+		s.nodes[node] = elements[low]
+		return
+	}
+	mid := (low + high) / 2
+	s.build(2*node+1, low, mid, elements)
+	s.build(2*node+2, mid, high, elements)
+	s.nodes[node] = s.combine(s.nodes[2*node+1], s.nodes[2*node+2])
+}
+
+func (s *SegmentTree[T]) query(node, low, high, from, to int) T {
+	if from <= low && high <= to {
+		return s.nodes[node]
+	}
+	s.push(node, low, high)
+	mid := (low + high) / 2
+	switch {
+	case to <= mid:
+		return s.query(2*node+1, low, mid, from, to)
+	case from >= mid:
+		return s.query(2*node+2, mid, high, from, to)
+	}
+	return s.combine(s.query(2*node+1, low, mid, from, to), s.query(2*node+2, mid, high, from, to))
+}
+
+func (s *SegmentTree[T]) assign(node, low, high, from, to int, t T) {
+	if from <= low && high <= to {
+		s.apply(node, low, high, t)
+		return
+	}
+	s.push(node, low, high)
+	mid := (low + high) / 2
+	if from < mid {
+		s.assign(2*node+1, low, mid, from, to, t)
+	}
+	if to > mid {
+		s.assign(2*node+2, mid, high, from, to, t)
+	}
+	s.nodes[node] = s.combine(s.nodes[2*node+1], s.nodes[2*node+2])
+}
+
+// apply assigns the value to the range of the node, leaving the assignment of its children pending.
+func (s *SegmentTree[T]) apply(node, low, high int, t T) {
+	s.nodes[node] = s.repeat(t, high-low)
+	if high-low > 1 {
+		s.pending[node], s.hasPending[node] = t, true
+	}
+}
+
+// push moves the pending assignment of the node to its children.
+func (s *SegmentTree[T]) push(node, low, high int) {
+	if !s.hasPending[node] {
+		return
+	}
+	mid := (low + high) / 2
+	s.apply(2*node+1, low, mid, s.pending[node])
+	s.apply(2*node+2, mid, high, s.pending[node])
+	var zero T
+	s.pending[node], s.hasPending[node] = zero, false
+}
+
+// repeat returns the aggregate of n copies of the value, combining them by squaring.
+func (s *SegmentTree[T]) repeat(t T, n int) T {
+	result, found := t, false
+	for {
+		if n&1 == 1 {
+			if found {
+				result = s.combine(result, t)
+			} else {
+				result, found = t, true
+			}
+		}
+		n >>= 1
+		if n == 0 {
+			return result
+		}
+		t = s.combine(t, t)
+	}
+}
+
+func (s *SegmentTree[T]) checkRange(from, to int) {
+	if from < 0 || to > s.size || from > to {
+		panic(ErrIndexOutOfBounds)
+	}
+}
@@ -0,0 +1,90 @@
+package collections
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+)
+
+func TestSegmentTree_Query(t *testing.T) {
+	elements := []int{12, 18, 6, 30, 9, 24}
+
+	tests := []struct {
+		name     string
+		combine  func(a, b int) int
+		from, to int
+		expected int
+	}{
+		{name: "Sum", combine: SegmentSum[int], from: 1, to: 4, expected: 54},
+		{name: "Min", combine: SegmentMin[int], from: 0, to: 6, expected: 6},
+		{name: "Max", combine: SegmentMax[int], from: 4, to: 6, expected: 24},
+		{name: "GCD", combine: SegmentGCD[int], from: 0, to: 2, expected: 6},
+		{name: "GCD all", combine: SegmentGCD[int], from: 0, to: 6, expected: 3},
+		{name: "Single", combine: SegmentSum[int], from: 3, to: 4, expected: 30},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSegmentTree(elements, tt.combine)
+			assert.Equal(t, tt.expected, s.Query(tt.from, tt.to))
+		})
+	}
+}
+
+func TestSegmentTree_SetRange(t *testing.T) {
+	s := NewSegmentTree([]int{1, 2, 3, 4, 5, 6, 7}, SegmentSum[int])
+
+	s.SetRange(1, 5, 10)
+	assert.Equal(t, 40, s.Query(1, 5))
+	assert.Equal(t, 20, s.Query(2, 4))
+
+	s.Set(3, 0)
+	s.SetRange(4, 7, 1)
+	assert.Equal(t, []int{1, 10, 10, 0, 1, 1, 1}, s.ToArray())
+	assert.Equal(t, 24, s.Query(0, 7))
+	assert.Equal(t, 10, s.Get(2))
+}
+
+func TestSegmentTree_OutOfBounds(t *testing.T) {
+	s := NewSegmentTree([]string{"a", "b"}, func(a, b string) string { return a + b })
+
+	assert.Equal(t, "ab", s.Query(0, 2))
+	assert.PanicsWithValue(t, ErrEmptyRange, func() { s.Query(1, 1) })
+	assert.PanicsWithValue(t, ErrIndexOutOfBounds, func() { s.Query(0, 3) })
+	assert.PanicsWithValue(t, ErrIndexOutOfBounds, func() { s.Set(-1, "c") })
+	assert.PanicsWithValue(t, ErrEmptyRange, func() { NewSegmentTree([]int{}, SegmentSum[int]).Query(0, 0) })
+}
+
+func TestSegmentTree_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	expected := make([]int, 77)
+	for i := range expected {
+		expected[i] = r.Intn(1000)
+	}
+	sum := NewSegmentTree(expected, SegmentSum[int])
+	min := NewSegmentTree(expected, SegmentMin[int])
+
+	for i := 0; i < 2000; i++ {
+		from := r.Intn(len(expected))
+		to := from + 1 + r.Intn(len(expected)-from)
+
+		if r.Intn(2) == 0 {
+			value := r.Intn(1000)
+			sum.SetRange(from, to, value)
+			min.SetRange(from, to, value)
+			for j := from; j < to; j++ {
+				expected[j] = value
+			}
+			continue
+		}
+
+		expectedSum, expectedMin := 0, expected[from]
+		for j := from; j < to; j++ {
+			expectedSum += expected[j]
+			expectedMin = SegmentMin(expectedMin, expected[j])
+		}
+		assert.Equal(t, expectedSum, sum.Query(from, to))
+		assert.Equal(t, expectedMin, min.Query(from, to))
+	}
+	assert.Equal(t, expected, sum.ToArray())
+}
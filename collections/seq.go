@@ -0,0 +1,90 @@
+//go:build go1.23
+
+package collections
+
+import "iter"
+
+// SeqFromIterator returns an iter.Seq that yields the remaining elements of the iterator.
+func SeqFromIterator[T any](it Iterator[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it.HasNext() {
+			if !yield(it.Next()) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2FromIterator returns an iter.Seq2 that yields the key/value pairs of the remaining entries of the iterator.
+func Seq2FromIterator[K comparable, T any](it Iterator[*Entry[K, T]]) iter.Seq2[K, T] {
+	return func(yield func(K, T) bool) {
+		for it.HasNext() {
+			e := it.Next()
+			if !yield(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+// IteratorFromSeq instantiates a new iterator from an iter.Seq, the sequence is consumed eagerly.
+func IteratorFromSeq[T any](seq iter.Seq[T]) *ChannelIterator[T] {
+	var elements []T
+	for e := range seq {
+		elements = append(elements, e)
+	}
+	return IteratorFromSlice(elements)
+}
+
+// IteratorFromSeq2 instantiates a new entry iterator from an iter.Seq2, the sequence is consumed eagerly.
+func IteratorFromSeq2[K comparable, T any](seq iter.Seq2[K, T]) *ChannelIterator[*Entry[K, T]] {
+	var entries []*Entry[K, T]
+	for k, v := range seq {
+		entries = append(entries, &Entry[K, T]{key: k, value: v})
+	}
+	return IteratorFromSlice(entries)
+}
+
+// All returns an iterator over the index/element pairs of this list in proper sequence.
+func (a *ArrayList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, e := range a.elements {
+			if !yield(i, e) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the index/element pairs of this list in reverse sequence.
+func (a *ArrayList[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := len(a.elements) - 1; i >= 0; i-- {
+			if !yield(i, a.elements[i]) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the elements of this set, the order is not specified.
+func (h *ValueSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for k := range h.elements {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the key/value pairs of the dictionary, the order is not specified.
+func (d Dictionary[K, T]) All() iter.Seq2[K, T] {
+	return func(yield func(K, T) bool) {
+		for k, v := range d {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
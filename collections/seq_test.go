@@ -0,0 +1,111 @@
+//go:build go1.23
+
+package collections
+
+import (
+	"github.com/stretchr/testify/assert"
+	"sort"
+	"testing"
+)
+
+func TestSeqFromIterator(t *testing.T) {
+	var actual []int
+	for v := range SeqFromIterator[int](IteratorFromSlice([]int{1, 2, 3})) {
+		actual = append(actual, v)
+	}
+	assert.Equal(t, []int{1, 2, 3}, actual)
+}
+
+func TestSeqFromIterator_Break(t *testing.T) {
+	it := IteratorFromSlice([]int{1, 2, 3})
+	for v := range SeqFromIterator[int](it) {
+		if v == 2 {
+			break
+		}
+	}
+	assert.True(t, it.HasNext())
+	assert.Equal(t, 3, it.Next())
+}
+
+func TestSeq2FromIterator(t *testing.T) {
+	d := Dictionary[string, int]{"a": 1, "b": 2}
+	actual := Dictionary[string, int]{}
+	for k, v := range Seq2FromIterator[string, int](d.Iterator()) {
+		actual[k] = v
+	}
+	assert.Equal(t, d, actual)
+}
+
+func TestIteratorFromSeq(t *testing.T) {
+	list := NewArrayListWithElements([]int{1, 2, 3})
+	it := IteratorFromSeq(NewValueSetWithElements([]int{1, 2, 3}).All())
+
+	var actual []int
+	for it.HasNext() {
+		actual = append(actual, it.Next())
+	}
+	sort.Ints(actual)
+	assert.Equal(t, list.ToArray(), actual)
+}
+
+func TestIteratorFromSeq2(t *testing.T) {
+	d := Dictionary[string, int]{"a": 1, "b": 2}
+	it := IteratorFromSeq2(d.All())
+
+	actual := Dictionary[string, int]{}
+	for it.HasNext() {
+		e := it.Next()
+		actual[e.Key()] = e.Value()
+	}
+	assert.Equal(t, d, actual)
+}
+
+func TestArrayList_All(t *testing.T) {
+	list := NewArrayListWithElements([]string{"a", "b", "c"})
+
+	var indexes []int
+	var values []string
+	for i, v := range list.All() {
+		indexes = append(indexes, i)
+		values = append(values, v)
+	}
+	assert.Equal(t, []int{0, 1, 2}, indexes)
+	assert.Equal(t, []string{"a", "b", "c"}, values)
+}
+
+func TestArrayList_Backward(t *testing.T) {
+	list := NewArrayListWithElements([]string{"a", "b", "c"})
+
+	var indexes []int
+	var values []string
+	for i, v := range list.Backward() {
+		indexes = append(indexes, i)
+		values = append(values, v)
+	}
+	assert.Equal(t, []int{2, 1, 0}, indexes)
+	assert.Equal(t, []string{"c", "b", "a"}, values)
+}
+
+func TestValueSet_All(t *testing.T) {
+	set := NewValueSetWithElements([]int{3, 1, 2})
+
+	var actual []int
+	for v := range set.All() {
+		actual = append(actual, v)
+	}
+	sort.Ints(actual)
+	assert.Equal(t, []int{1, 2, 3}, actual)
+}
+
+func TestDictionary_All(t *testing.T) {
+	d := Dictionary[string, int]{"a": 1, "b": 2, "c": 3}
+
+	actual := Dictionary[string, int]{}
+	for k, v := range d.All() {
+		if k == "c" {
+			continue
+		}
+		actual[k] = v
+	}
+	assert.Equal(t, Dictionary[string, int]{"a": 1, "b": 2}, actual)
+}
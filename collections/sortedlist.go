@@ -0,0 +1,168 @@
+package collections
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/ovargas/go-lib/compare"
+	"github.com/ovargas/go-lib/slice"
+)
+
+type (
+
+	// SortedList is a List that keeps its elements ordered by a comparator.
+	SortedList[T any] struct {
+		elements []T
+		cmp      compare.Comparator[T]
+	}
+)
+
+var _ List[any] = (*SortedList[any])(nil)
+
+var (
+	ErrOutOfOrder = errors.New("element breaks the order of the list")
+)
+
+// NewSortedList returns a new SortedList ordered by the comparator.
+func NewSortedList[T any](cmp compare.Comparator[T]) *SortedList[T] {
+	return &SortedList[T]{
+		elements: make([]T, 0),
+		cmp:      cmp,
+	}
+}
+
+// NewSortedListWithElements returns a new SortedList ordered by the comparator with the specified elements.
+func NewSortedListWithElements[T any](cmp compare.Comparator[T], elements []T) *SortedList[T] {
+	var l = &SortedList[T]{
+		elements: make([]T, len(elements)),
+		cmp:      cmp,
+	}
+	copy(l.elements, elements)
+	slice.SortStable(l.elements, cmp)
+	return l
+}
+
+// Add inserts the specified element at its ordered position, after any equal element.
+func (l *SortedList[T]) Add(t T) bool {
+	l.Insert(t)
+	return true
+}
+
+// Insert inserts the specified element at its ordered position, after any equal element, and returns that position.
+func (l *SortedList[T]) Insert(t T) int {
+	i := sort.Search(len(l.elements), func(i int) bool {
+		return l.cmp(l.elements[i], t) > 0
+	})
+	l.elements = insertAt(l.elements, i, t)
+	return i
+}
+
+// AddAt adds the specified element at the specified position in this list, it returns false if the position is out
+// of range or the element does not belong at that position.
+func (l *SortedList[T]) AddAt(i int, t T) bool {
+	if i < 0 || i > len(l.elements) {
+		return false
+	}
+	if (i > 0 && l.cmp(l.elements[i-1], t) > 0) || (i < len(l.elements) && l.cmp(t, l.elements[i]) > 0) {
+		return false
+	}
+	l.elements = insertAt(l.elements, i, t)
+	return true
+}
+
+// AddAll inserts all the elements in the specified collection at their ordered positions.
+func (l *SortedList[T]) AddAll(ts []T) bool {
+	for _, t := range ts {
+		l.Add(t)
+	}
+	return true
+}
+
+// Remove removes the first element equal to the specified element according to the comparator.
+func (l *SortedList[T]) Remove(t T) bool {
+	i := l.IndexOf(t)
+	if i == -1 {
+		return false
+	}
+	l.RemoveAt(i)
+	return true
+}
+
+// RemoveAt removes the element at the specified position in this list.
+func (l *SortedList[T]) RemoveAt(i int) T {
+	var e = l.elements[i]
+	l.elements = append(l.elements[:i], l.elements[i+1:]...)
+	return e
+}
+
+// RemoveIf removes all the elements that satisfy the given predicate.
+func (l *SortedList[T]) RemoveIf(f Predicate[T]) bool {
+	removed := false
+	for i := 0; i < len(l.elements); i++ {
+		if f(l.elements[i]) {
+			l.elements = append(l.elements[:i], l.elements[i+1:]...)
+			i -= 1
+			removed = true
+		}
+	}
+	return removed
+}
+
+// Contains returns true if this list contains an element equal to the specified element according to the comparator.
+func (l *SortedList[T]) Contains(t T) bool {
+	_, found := slice.BinarySearch(l.elements, t, l.cmp)
+	return found
+}
+
+// IndexOf returns the index of the first element equal to the specified element according to the comparator,
+// or -1 if this list does not contain the element.
+func (l *SortedList[T]) IndexOf(t T) int {
+	i, found := slice.BinarySearch(l.elements, t, l.cmp)
+	if !found {
+		return -1
+	}
+	return i
+}
+
+// IsEmpty returns true if this list contains no elements.
+func (l *SortedList[T]) IsEmpty() bool {
+	return len(l.elements) == 0
+}
+
+// Clear removes all the elements from this list.
+func (l *SortedList[T]) Clear() {
+	l.elements = nil
+}
+
+// Size returns the number of elements in this list.
+func (l *SortedList[T]) Size() int {
+	return len(l.elements)
+}
+
+// Get returns the element at the specified position in this list.
+func (l *SortedList[T]) Get(i int) T {
+	return l.elements[i]
+}
+
+// Set replaces the element at the specified position in this list with the specified element. It panics with
+// ErrOutOfOrder if the element does not belong at that position.
+func (l *SortedList[T]) Set(i int, t T) T {
+	old := l.elements[i]
+	if (i > 0 && l.cmp(l.elements[i-1], t) > 0) || (i < len(l.elements)-1 && l.cmp(t, l.elements[i+1]) > 0) {
+		panic(ErrOutOfOrder)
+	}
+	l.elements[i] = t
+	return old
+}
+
+// ToArray returns an array containing all the elements in this list in order.
+func (l *SortedList[T]) ToArray() []T {
+	arrays := make([]T, len(l.elements))
+	copy(arrays, l.elements)
+	return arrays
+}
+
+// Iterator returns an iterator over the elements in this list in order.
+func (l *SortedList[T]) Iterator() Iterator[T] {
+	return IteratorFromSlice[T](l.elements)
+}
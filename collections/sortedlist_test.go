@@ -0,0 +1,75 @@
+package collections
+
+import (
+	"github.com/ovargas/go-lib/compare"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var compareInt = compare.Natural[int]()
+
+func TestSortedList_Add(t *testing.T) {
+	list := NewSortedList(compareInt)
+	list.Add(3)
+	list.Add(1)
+	list.Add(2)
+	list.Add(1)
+
+	assert.Equal(t, []int{1, 1, 2, 3}, list.ToArray())
+}
+
+func TestSortedList_Insert(t *testing.T) {
+	l := NewSortedListWithElements(compareInt, []int{1, 2, 2, 3})
+
+	assert.Equal(t, 3, l.Insert(2))
+	assert.Equal(t, 0, l.Insert(0))
+	assert.Equal(t, 6, l.Insert(4))
+	assert.Equal(t, []int{0, 1, 2, 2, 2, 3, 4}, l.ToArray())
+}
+
+func TestSortedList_WithElements(t *testing.T) {
+	list := NewSortedListWithElements(compareInt, []int{5, 3, 4})
+	list.AddAll([]int{1, 6})
+
+	assert.Equal(t, []int{1, 3, 4, 5, 6}, list.ToArray())
+}
+
+func TestSortedList_AddAt(t *testing.T) {
+	list := NewSortedListWithElements(compareInt, []int{1, 3, 5})
+
+	assert.True(t, list.AddAt(1, 2))
+	assert.True(t, list.AddAt(4, 5))
+	assert.False(t, list.AddAt(0, 4))
+	assert.False(t, list.AddAt(6, 1))
+
+	assert.Equal(t, []int{1, 2, 3, 5, 5}, list.ToArray())
+}
+
+func TestSortedList_Set(t *testing.T) {
+	list := NewSortedListWithElements(compareInt, []int{1, 3, 5})
+
+	assert.Equal(t, 3, list.Set(1, 4))
+	assert.PanicsWithValue(t, ErrOutOfOrder, func() { list.Set(1, 6) })
+
+	assert.Equal(t, []int{1, 4, 5}, list.ToArray())
+}
+
+func TestSortedList_Remove(t *testing.T) {
+	list := NewSortedListWithElements(compareInt, []int{1, 2, 2, 3})
+
+	assert.True(t, list.Remove(2))
+	assert.False(t, list.Remove(4))
+	assert.Equal(t, 2, list.RemoveAt(1))
+	assert.True(t, list.RemoveIf(func(i int) bool { return i > 2 }))
+
+	assert.Equal(t, []int{1}, list.ToArray())
+}
+
+func TestSortedList_IndexOf(t *testing.T) {
+	list := NewSortedListWithElements(compareInt, []int{1, 2, 2, 3})
+
+	assert.Equal(t, 1, list.IndexOf(2))
+	assert.Equal(t, -1, list.IndexOf(4))
+	assert.True(t, list.Contains(3))
+	assert.False(t, list.Contains(0))
+}
@@ -0,0 +1,335 @@
+package collections
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type (
+	postgresArray[T any] struct {
+		collection Collection[T]
+	}
+)
+
+var (
+	_ sql.Scanner   = (*ArrayList[any])(nil)
+	_ driver.Valuer = ArrayList[any]{}
+	_ sql.Scanner   = (*ValueSet[string])(nil)
+	_ driver.Valuer = ValueSet[string]{}
+	_ sql.Scanner   = (*Dictionary[string, any])(nil)
+	_ driver.Valuer = Dictionary[string, any]{}
+)
+
+var (
+	ErrInvalidArrayLiteral = errors.New("invalid array literal")
+	ErrUnsupportedScan     = errors.New("unsupported scan source")
+)
+
+// Value stores the list as a JSON array, use PostgresArray to store it in a PostgreSQL array column.
+func (a ArrayList[T]) Value() (driver.Value, error) {
+	return jsonValue(a)
+}
+
+// Scan replaces the elements of the list with the elements of a JSON array or a PostgreSQL array literal, a NULL
+// value leaves the list empty.
+func (a *ArrayList[T]) Scan(src any) error {
+	elements, err := scanArray[T](src)
+	if err != nil {
+		return err
+	}
+	a.elements = elements
+	return nil
+}
+
+// Value stores the set as a JSON array, use PostgresArray to store it in a PostgreSQL array column.
+func (h ValueSet[T]) Value() (driver.Value, error) {
+	return jsonValue(h)
+}
+
+// Scan replaces the elements of the set with the elements of a JSON array or a PostgreSQL array literal, a NULL
+// value leaves the set empty.
+func (h *ValueSet[T]) Scan(src any) error {
+	elements, err := scanArray[T](src)
+	if err != nil {
+		return err
+	}
+	h.elements = NewValueSetWithElements(elements).elements
+	return nil
+}
+
+// Value stores the dictionary as a JSON object.
+func (d Dictionary[K, T]) Value() (driver.Value, error) {
+	return jsonValue(d)
+}
+
+// Scan replaces the entries of the dictionary with the entries of a JSON object, a NULL value leaves the dictionary empty.
+func (d *Dictionary[K, T]) Scan(src any) error {
+	data, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		*d = make(Dictionary[K, T])
+		return nil
+	}
+	return d.UnmarshalJSON(data)
+}
+
+// PostgresArray returns a driver.Valuer that stores the elements of the collection as a PostgreSQL array literal,
+// every element is written as a quoted string and nil elements as NULL.
+func PostgresArray[T any](c Collection[T]) driver.Valuer {
+	return postgresArray[T]{collection: c}
+}
+
+// Value returns the PostgreSQL array literal of the collection.
+func (p postgresArray[T]) Value() (driver.Value, error) {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, e := range p.collection.ToArray() {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		s, ok, err := formatArrayElement(e)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			b.WriteString("NULL")
+			continue
+		}
+		b.WriteByte('"')
+		for _, r := range s {
+			if r == '"' || r == '\\' {
+				b.WriteByte('\\')
+			}
+			b.WriteRune(r)
+		}
+		b.WriteByte('"')
+	}
+	b.WriteByte('}')
+	return b.String(), nil
+}
+
+func jsonValue(v json.Marshaler) (driver.Value, error) {
+	data, err := v.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// scanBytes returns the bytes of a []byte or string source, or nil for a NULL source.
+func scanBytes(src any) ([]byte, error) {
+	switch v := src.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return v, nil
+	case string:
+		return []byte(v), nil
+	}
+	return nil, fmt.Errorf("%w: %T", ErrUnsupportedScan, src)
+}
+
+func scanArray[T any](src any) ([]T, error) {
+	data, err := scanBytes(src)
+	if err != nil {
+		return nil, err
+	}
+	text := strings.TrimSpace(string(data))
+	if text == "" {
+		return make([]T, 0), nil
+	}
+	if text[0] == '[' {
+		elements := make([]T, 0)
+		if err := json.Unmarshal([]byte(text), &elements); err != nil {
+			return nil, err
+		}
+		return elements, nil
+	}
+
+	values, err := parseArrayLiteral(text)
+	if err != nil {
+		return nil, err
+	}
+	elements := make([]T, len(values))
+	for i, v := range values {
+		if err := parseArrayElement(v, &elements[i]); err != nil {
+			return nil, fmt.Errorf("array element %d: %w", i, err)
+		}
+	}
+	return elements, nil
+}
+
+// parseArrayLiteral parses a one dimensional PostgreSQL array literal, NULL elements are returned as nil.
+func parseArrayLiteral(text string) ([]*string, error) {
+	if len(text) < 2 || text[0] != '{' || text[len(text)-1] != '}' {
+		return nil, ErrInvalidArrayLiteral
+	}
+	body := text[1 : len(text)-1]
+	values := make([]*string, 0)
+	if strings.TrimSpace(body) == "" {
+		return values, nil
+	}
+
+	for i := 0; ; {
+		for i < len(body) && body[i] == ' ' {
+			i++
+		}
+
+		var b strings.Builder
+		quoted := i < len(body) && body[i] == '"'
+		if quoted {
+			i++
+			for ; i < len(body) && body[i] != '"'; i++ {
+				if body[i] == '\\' {
+					i++
+					if i == len(body) {
+						return nil, ErrInvalidArrayLiteral
+					}
+				}
+				b.WriteByte(body[i])
+			}
+			if i == len(body) {
+				return nil, ErrInvalidArrayLiteral
+			}
+			i++
+			for i < len(body) && body[i] == ' ' {
+				i++
+			}
+		} else {
+			for ; i < len(body) && body[i] != ','; i++ {
+				switch body[i] {
+				case '{', '}', '"':
+					return nil, ErrInvalidArrayLiteral
+				case '\\':
+					i++
+					if i == len(body) {
+						return nil, ErrInvalidArrayLiteral
+					}
+				}
+				b.WriteByte(body[i])
+			}
+		}
+
+		value := b.String()
+		if !quoted {
+			value = strings.TrimSpace(value)
+			if value == "" {
+				return nil, ErrInvalidArrayLiteral
+			}
+		}
+		if !quoted && strings.EqualFold(value, "NULL") {
+			values = append(values, nil)
+		} else {
+			values = append(values, &value)
+		}
+
+		if i == len(body) {
+			return values, nil
+		}
+		if body[i] != ',' {
+			return nil, ErrInvalidArrayLiteral
+		}
+		i++
+	}
+}
+
+// parseArrayElement converts the text of an array element into the element type, a nil text leaves the zero value.
+func parseArrayElement[T any](text *string, target *T) error {
+	if text == nil {
+		return nil
+	}
+
+	v := reflect.ValueOf(target).Elem()
+	if v.Kind() == reflect.Ptr {
+		v.Set(reflect.New(v.Type().Elem()))
+		v = v.Elem()
+	}
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(*text))
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(*text)
+	case reflect.Bool:
+		b, err := parsePostgresBool(*text)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(*text, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(*text, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(*text, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Interface:
+		v.Set(reflect.ValueOf(*text))
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedScan, v.Type())
+	}
+	return nil
+}
+
+func parsePostgresBool(text string) (bool, error) {
+	switch strings.ToLower(text) {
+	case "t", "true", "y", "yes", "on", "1":
+		return true, nil
+	case "f", "false", "n", "no", "off", "0":
+		return false, nil
+	}
+	return false, fmt.Errorf("invalid boolean %q", text)
+}
+
+// formatArrayElement returns the text of an array element, or false if the element is nil.
+func formatArrayElement(e any) (string, bool, error) {
+	if valuer, ok := e.(driver.Valuer); ok {
+		v, err := valuer.Value()
+		if err != nil {
+			return "", false, err
+		}
+		e = v
+	}
+
+	v := reflect.ValueOf(e)
+	if !v.IsValid() {
+		return "", false, nil
+	}
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return "", false, nil
+		}
+		e = v.Elem().Interface()
+	}
+
+	switch t := e.(type) {
+	case string:
+		return t, true, nil
+	case []byte:
+		return string(t), true, nil
+	case encoding.TextMarshaler:
+		text, err := t.MarshalText()
+		return string(text), true, err
+	}
+	return fmt.Sprint(e), true, nil
+}
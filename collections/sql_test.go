@@ -0,0 +1,197 @@
+package collections
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"github.com/ovargas/go-lib/constant"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+type (
+	// fakeDriver is a database/sql driver that stores a single column of values in memory, every statement with
+	// arguments inserts them and every statement without arguments selects all the stored values.
+	fakeDriver struct {
+		rows []driver.Value
+	}
+
+	fakeConn struct {
+		driver *fakeDriver
+	}
+
+	fakeStmt struct {
+		conn *fakeConn
+	}
+
+	fakeRows struct {
+		values []driver.Value
+	}
+)
+
+var fake = &fakeDriver{}
+
+func init() {
+	sql.Register("collections-fake", fake)
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) {
+	return &fakeConn{driver: d}, nil
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) {
+	return &fakeStmt{conn: c}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.conn.driver.rows = append(s.conn.driver.rows, args...)
+	return driver.RowsAffected(len(args)), nil
+}
+
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &fakeRows{values: s.conn.driver.rows}, nil
+}
+
+func (r *fakeRows) Columns() []string {
+	return []string{"value"}
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	dest[0], r.values = r.values[0], r.values[1:]
+	return nil
+}
+
+func openFake(t *testing.T) *sql.DB {
+	fake.rows = nil
+	db, err := sql.Open("collections-fake", "")
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+func TestArrayList_SQL(t *testing.T) {
+	db := openFake(t)
+
+	_, err := db.Exec("INSERT", NewArrayListWithElements([]string{"a", "b"}))
+	assert.NoError(t, err)
+	_, err = db.Exec("INSERT", PostgresArray[string](NewArrayListWithElements([]string{`c "d"`, `e\f`})))
+	assert.NoError(t, err)
+	_, err = db.Exec("INSERT", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []driver.Value{`["a","b"]`, `{"c \"d\"","e\\f"}`, nil}, fake.rows)
+
+	rows, err := db.Query("SELECT")
+	assert.NoError(t, err)
+	var actual [][]string
+	for rows.Next() {
+		list := NewArrayList[string]()
+		assert.NoError(t, rows.Scan(list))
+		actual = append(actual, list.ToArray())
+	}
+	assert.NoError(t, rows.Err())
+	assert.Equal(t, [][]string{{"a", "b"}, {`c "d"`, `e\f`}, {}}, actual)
+}
+
+func TestValueSet_SQL(t *testing.T) {
+	db := openFake(t)
+
+	_, err := db.Exec("INSERT", NewValueSetWithElements([]int{2, 1}))
+	assert.NoError(t, err)
+	assert.Equal(t, []driver.Value{`[1,2]`}, fake.rows)
+
+	var actual ValueSet[int]
+	assert.NoError(t, db.QueryRow("SELECT").Scan(&actual))
+	assert.Equal(t, []int{1, 2}, actual.sortedArray())
+}
+
+func TestDictionary_SQL(t *testing.T) {
+	db := openFake(t)
+
+	_, err := db.Exec("INSERT", Dictionary[string, int]{"b": 2, "a": 1})
+	assert.NoError(t, err)
+	assert.Equal(t, []driver.Value{`{"a":1,"b":2}`}, fake.rows)
+
+	var actual Dictionary[string, int]
+	assert.NoError(t, db.QueryRow("SELECT").Scan(&actual))
+	assert.Equal(t, Dictionary[string, int]{"a": 1, "b": 2}, actual)
+}
+
+func TestArrayList_Scan_PostgresArray(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     any
+		want    []*string
+		wantErr error
+	}{
+		{name: "Empty", src: `{}`, want: []*string{}},
+		{name: "Unquoted", src: []byte(`{a, b ,c}`), want: []*string{constant.AsPointer("a"), constant.AsPointer("b"), constant.AsPointer("c")}},
+		{name: "Quoted", src: `{"a,b","",  "c\"d" ,"e\\f"}`, want: []*string{constant.AsPointer("a,b"), constant.AsPointer(""), constant.AsPointer(`c"d`), constant.AsPointer(`e\f`)}},
+		{name: "Null", src: `{NULL,"NULL",null}`, want: []*string{nil, constant.AsPointer("NULL"), nil}},
+		{name: "Escaped unquoted", src: `{a\,b}`, want: []*string{constant.AsPointer("a,b")}},
+		{name: "Not an array", src: `a,b`, wantErr: ErrInvalidArrayLiteral},
+		{name: "Unterminated quote", src: `{"a}`, wantErr: ErrInvalidArrayLiteral},
+		{name: "Multidimensional", src: `{{1,2},{3,4}}`, wantErr: ErrInvalidArrayLiteral},
+		{name: "Empty element", src: `{a,,b}`, wantErr: ErrInvalidArrayLiteral},
+		{name: "Unsupported source", src: 1, wantErr: ErrUnsupportedScan},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := NewArrayList[*string]()
+			err := list.Scan(tt.src)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, list.ToArray())
+		})
+	}
+}
+
+func TestArrayList_Scan_PostgresArray_Types(t *testing.T) {
+	ints := NewArrayList[int]()
+	assert.NoError(t, ints.Scan(`{1,-2,"3"}`))
+	assert.Equal(t, []int{1, -2, 3}, ints.ToArray())
+	assert.Error(t, ints.Scan(`{a}`))
+
+	bools := NewArrayList[bool]()
+	assert.NoError(t, bools.Scan(`{t,f,true}`))
+	assert.Equal(t, []bool{true, false, true}, bools.ToArray())
+
+	floats := NewValueSet[float64]()
+	assert.NoError(t, floats.Scan(`{1.5,1.5}`))
+	assert.Equal(t, []float64{1.5}, floats.ToArray())
+}
+
+func TestPostgresArray(t *testing.T) {
+	value, err := PostgresArray[*int](NewArrayListWithElements([]*int{constant.AsPointer(1), nil, constant.AsPointer(3)})).Value()
+
+	assert.NoError(t, err)
+	assert.Equal(t, `{"1",NULL,"3"}`, value)
+}
@@ -0,0 +1,143 @@
+package collections
+
+type (
+
+	// subList is a view of a range of an ArrayList, changes made through the view are written to the ArrayList.
+	subList[T any] struct {
+		parent *ArrayList[T]
+		offset int
+		size   int
+	}
+)
+
+var _ List[any] = (*subList[any])(nil)
+
+// SubList returns a view of the portion of this list between from, inclusive, and to, exclusive. Changes made through
+// the view are reflected in this list, structural changes made to this list directly leave the view undefined.
+// It panics with ErrIndexOutOfBounds if the range is out of bounds.
+func (a *ArrayList[T]) SubList(from, to int) List[T] {
+	if from < 0 || to > len(a.elements) || from > to {
+		panic(ErrIndexOutOfBounds)
+	}
+	return &subList[T]{parent: a, offset: from, size: to - from}
+}
+
+// view returns the elements of the view, it panics with ErrIndexOutOfBounds if the list was shrunk below the view.
+func (s *subList[T]) view() []T {
+	if s.offset+s.size > len(s.parent.elements) {
+		panic(ErrIndexOutOfBounds)
+	}
+	return s.parent.elements[s.offset : s.offset+s.size]
+}
+
+// Add adds the specified element at the end of this view.
+func (s *subList[T]) Add(t T) bool {
+	s.parent.elements = insertAt(s.parent.elements, s.offset+s.size, t)
+	s.size++
+	return true
+}
+
+// AddAt adds the specified element at the specified position in this view.
+func (s *subList[T]) AddAt(i int, t T) bool {
+	if i < 0 || i > s.size {
+		return false
+	}
+	s.parent.elements = insertAt(s.parent.elements, s.offset+i, t)
+	s.size++
+	return true
+}
+
+// AddAll adds all the elements in the specified collection at the end of this view.
+func (s *subList[T]) AddAll(ts []T) bool {
+	for _, t := range ts {
+		s.Add(t)
+	}
+	return true
+}
+
+// Clear removes all the elements of this view from the list.
+func (s *subList[T]) Clear() {
+	s.parent.elements = append(s.parent.elements[:s.offset], s.parent.elements[s.offset+s.size:]...)
+	s.size = 0
+}
+
+// Contains returns true if this view contains the specified element.
+func (s *subList[T]) Contains(t T) bool {
+	return s.IndexOf(t) != -1
+}
+
+// IndexOf returns the index of the first occurrence of the specified element in this view, or -1 if this view does not contain the element.
+func (s *subList[T]) IndexOf(t T) int {
+	for i, e := range s.view() {
+		if Equal[T](e, t) {
+			return i
+		}
+	}
+	return -1
+}
+
+// IsEmpty returns true if this view contains no elements.
+func (s *subList[T]) IsEmpty() bool {
+	return s.size == 0
+}
+
+// Remove removes the first occurrence of the specified element from this view, if it is present.
+func (s *subList[T]) Remove(t T) bool {
+	i := s.IndexOf(t)
+	if i == -1 {
+		return false
+	}
+	s.RemoveAt(i)
+	return true
+}
+
+// RemoveAt removes the element at the specified position in this view.
+func (s *subList[T]) RemoveAt(i int) T {
+	e := s.view()[i]
+	s.parent.RemoveAt(s.offset + i)
+	s.size--
+	return e
+}
+
+// RemoveIf removes all the elements of this view that satisfy the given predicate.
+func (s *subList[T]) RemoveIf(f Predicate[T]) bool {
+	removed := false
+	for i := 0; i < s.size; i++ {
+		if f(s.view()[i]) {
+			s.RemoveAt(i)
+			i -= 1
+			removed = true
+		}
+	}
+	return removed
+}
+
+// Size returns the number of elements in this view.
+func (s *subList[T]) Size() int {
+	return s.size
+}
+
+// Get returns the element at the specified position in this view.
+func (s *subList[T]) Get(i int) T {
+	return s.view()[i]
+}
+
+// Set replaces the element at the specified position in this view with the specified element.
+func (s *subList[T]) Set(i int, t T) T {
+	v := s.view()
+	old := v[i]
+	v[i] = t
+	return old
+}
+
+// ToArray returns an array containing all the elements in this view in proper sequence.
+func (s *subList[T]) ToArray() []T {
+	arrays := make([]T, s.size)
+	copy(arrays, s.view())
+	return arrays
+}
+
+// Iterator returns an iterator over the elements in this view in proper sequence.
+func (s *subList[T]) Iterator() Iterator[T] {
+	return IteratorFromSlice[T](s.view())
+}
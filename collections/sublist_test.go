@@ -0,0 +1,58 @@
+package collections
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestArrayList_SubList(t *testing.T) {
+	list := NewArrayListWithElements([]int{1, 2, 3, 4, 5})
+	sub := list.SubList(1, 4)
+
+	assert.Equal(t, 3, sub.Size())
+	assert.Equal(t, []int{2, 3, 4}, sub.ToArray())
+	assert.Equal(t, 3, sub.Get(1))
+	assert.Equal(t, 2, sub.IndexOf(4))
+	assert.False(t, sub.Contains(5))
+}
+
+func TestArrayList_SubList_WriteThrough(t *testing.T) {
+	list := NewArrayListWithElements([]int{1, 2, 3, 4, 5})
+	sub := list.SubList(1, 4)
+
+	sub.Set(0, 20)
+	sub.Add(6)
+	sub.AddAt(0, 7)
+	assert.Equal(t, []int{1, 7, 20, 3, 4, 6, 5}, list.ToArray())
+
+	assert.Equal(t, 20, sub.RemoveAt(1))
+	assert.True(t, sub.Remove(6))
+	sub.RemoveIf(func(i int) bool { return i == 3 })
+	assert.Equal(t, []int{7, 4}, sub.ToArray())
+	assert.Equal(t, []int{1, 7, 4, 5}, list.ToArray())
+
+	sub.Clear()
+	assert.True(t, sub.IsEmpty())
+	assert.Equal(t, []int{1, 5}, list.ToArray())
+}
+
+func TestArrayList_SubList_OutOfBounds(t *testing.T) {
+	list := NewArrayListWithElements([]int{1, 2, 3})
+
+	assert.Panics(t, func() { list.SubList(2, 4) })
+	assert.Panics(t, func() { list.SubList(2, 1) })
+	assert.Panics(t, func() { list.SubList(0, 2).Get(2) })
+}
+
+func TestArrayList_SubList_PastLengthAfterAdd(t *testing.T) {
+	list := NewArrayListWithElements([]int{1, 2, 3})
+	list.Add(4)
+
+	assert.PanicsWithValue(t, ErrIndexOutOfBounds, func() { list.SubList(2, 6) })
+	assert.PanicsWithValue(t, ErrIndexOutOfBounds, func() { list.SubList(-1, 2) })
+	assert.Equal(t, []int{3, 4}, list.SubList(2, 4).ToArray())
+
+	sub := list.SubList(2, 4)
+	list.RemoveAt(3)
+	assert.PanicsWithValue(t, ErrIndexOutOfBounds, func() { sub.ToArray() })
+}
@@ -0,0 +1,253 @@
+package collections
+
+type (
+
+	// Table is a two-dimensional map that associates a value to every pair of row and column keys. It is indexed by
+	// row and by column, so the cells of a row or a column are found without scanning the table.
+	Table[R, C comparable, V any] struct {
+		rows    Dictionary[R, Dictionary[C, V]]
+		columns Dictionary[C, Dictionary[R, V]]
+		size    int
+	}
+
+	// Cell is a value of a Table along with its row and column keys.
+	Cell[R, C comparable, V any] struct {
+		row    R
+		column C
+		value  V
+	}
+
+	// tableView is a live Map over the cells of a row or a column, its changes are made through the table so both
+	// indexes stay consistent.
+	tableView[K comparable, V any] struct {
+		cells  func() Dictionary[K, V]
+		put    func(K, V)
+		remove func(K)
+	}
+)
+
+var (
+	_ Iterable[*Cell[string, string, any]] = (*Table[string, string, any])(nil)
+	_ Map[string, any]                     = (*tableView[string, any])(nil)
+)
+
+// NewTable returns a new empty Table.
+func NewTable[R, C comparable, V any]() *Table[R, C, V] {
+	return &Table[R, C, V]{
+		rows:    Dictionary[R, Dictionary[C, V]]{},
+		columns: Dictionary[C, Dictionary[R, V]]{},
+	}
+}
+
+// Row returns the row key of the cell.
+func (c *Cell[R, C, V]) Row() R {
+	return c.row
+}
+
+// Column returns the column key of the cell.
+func (c *Cell[R, C, V]) Column() C {
+	return c.column
+}
+
+// Value returns the value of the cell.
+func (c *Cell[R, C, V]) Value() V {
+	return c.value
+}
+
+// Put sets the value of the cell at the row and column.
+func (t *Table[R, C, V]) Put(r R, c C, value V) {
+	row := t.rows.ComputeIfAbsent(r, func(R) Dictionary[C, V] { return Dictionary[C, V]{} })
+	if !row.Has(c) {
+		t.size++
+	}
+	row.Set(c, value)
+	t.columns.ComputeIfAbsent(c, func(C) Dictionary[R, V] { return Dictionary[R, V]{} }).Set(r, value)
+}
+
+// Get returns the value of the cell at the row and column, or ErrKeyNotFound if the cell does not exist.
+func (t *Table[R, C, V]) Get(r R, c C) (V, error) {
+	return t.rows[r].Get(c)
+}
+
+// GetOrDefault returns the value of the cell at the row and column or the default value if the cell does not exist.
+func (t *Table[R, C, V]) GetOrDefault(r R, c C, value V) V {
+	return t.rows[r].GetOrDefault(c, value)
+}
+
+// Has returns true if the cell at the row and column exists.
+func (t *Table[R, C, V]) Has(r R, c C) bool {
+	return t.rows[r].Has(c)
+}
+
+// HasRow returns true if the row has at least one cell.
+func (t *Table[R, C, V]) HasRow(r R) bool {
+	return t.rows.Has(r)
+}
+
+// HasColumn returns true if the column has at least one cell.
+func (t *Table[R, C, V]) HasColumn(c C) bool {
+	return t.columns.Has(c)
+}
+
+// Remove removes the cell at the row and column, it returns false if the cell does not exist.
+func (t *Table[R, C, V]) Remove(r R, c C) bool {
+	if !t.Has(r, c) {
+		return false
+	}
+	t.rows[r].Remove(c)
+	if t.rows[r].Size() == 0 {
+		t.rows.Remove(r)
+	}
+	t.columns[c].Remove(r)
+	if t.columns[c].Size() == 0 {
+		t.columns.Remove(c)
+	}
+	t.size--
+	return true
+}
+
+// RemoveRow removes all the cells of the row.
+func (t *Table[R, C, V]) RemoveRow(r R) {
+	for c := range t.rows[r] {
+		t.Remove(r, c)
+	}
+}
+
+// RemoveColumn removes all the cells of the column.
+func (t *Table[R, C, V]) RemoveColumn(c C) {
+	for r := range t.columns[c] {
+		t.Remove(r, c)
+	}
+}
+
+// Row returns a view of the cells of the row keyed by column. The view reflects the later changes of the table, and
+// its changes are applied to the table.
+func (t *Table[R, C, V]) Row(r R) Map[C, V] {
+	return &tableView[C, V]{
+		cells:  func() Dictionary[C, V] { return t.rows[r] },
+		put:    func(c C, value V) { t.Put(r, c, value) },
+		remove: func(c C) { t.Remove(r, c) },
+	}
+}
+
+// Column returns a view of the cells of the column keyed by row. The view reflects the later changes of the table,
+// and its changes are applied to the table.
+func (t *Table[R, C, V]) Column(c C) Map[R, V] {
+	return &tableView[R, V]{
+		cells:  func() Dictionary[R, V] { return t.columns[c] },
+		put:    func(r R, value V) { t.Put(r, c, value) },
+		remove: func(r R) { t.Remove(r, c) },
+	}
+}
+
+// RowKeys returns the keys of the rows with at least one cell.
+func (t *Table[R, C, V]) RowKeys() []R {
+	return t.rows.Keys()
+}
+
+// ColumnKeys returns the keys of the columns with at least one cell.
+func (t *Table[R, C, V]) ColumnKeys() []C {
+	return t.columns.Keys()
+}
+
+// Size returns the number of cells in the table.
+func (t *Table[R, C, V]) Size() int {
+	return t.size
+}
+
+// IsEmpty returns true if the table has no cells.
+func (t *Table[R, C, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Clear removes all the cells.
+func (t *Table[R, C, V]) Clear() {
+	t.rows = Dictionary[R, Dictionary[C, V]]{}
+	t.columns = Dictionary[C, Dictionary[R, V]]{}
+	t.size = 0
+}
+
+// Transpose returns a new table with the rows and columns swapped.
+func (t *Table[R, C, V]) Transpose() *Table[C, R, V] {
+	transposed := &Table[C, R, V]{
+		rows:    make(Dictionary[C, Dictionary[R, V]], t.columns.Size()),
+		columns: make(Dictionary[R, Dictionary[C, V]], t.rows.Size()),
+		size:    t.size,
+	}
+	for c, column := range t.columns {
+		transposed.rows[c] = copyDictionary(column)
+	}
+	for r, row := range t.rows {
+		transposed.columns[r] = copyDictionary(row)
+	}
+	return transposed
+}
+
+// Cells returns the cells of the table.
+func (t *Table[R, C, V]) Cells() []*Cell[R, C, V] {
+	cells := make([]*Cell[R, C, V], 0, t.size)
+	for r, row := range t.rows {
+		for c, v := range row {
+			cells = append(cells, &Cell[R, C, V]{row: r, column: c, value: v})
+		}
+	}
+	return cells
+}
+
+// Iterator returns an iterator over the cells of the table.
+func (t *Table[R, C, V]) Iterator() Iterator[*Cell[R, C, V]] {
+	return IteratorFromSlice(t.Cells())
+}
+
+// Get returns the value of the key in this view, or ErrKeyNotFound if the key does not exist.
+func (v *tableView[K, V]) Get(k K) (V, error) {
+	return v.cells().Get(k)
+}
+
+// Set sets the value of the key in this view, adding the cell to the table.
+func (v *tableView[K, V]) Set(k K, value V) {
+	v.put(k, value)
+}
+
+// Has returns true if the key exists in this view.
+func (v *tableView[K, V]) Has(k K) bool {
+	return v.cells().Has(k)
+}
+
+// Remove removes the key from this view, removing the cell from the table.
+func (v *tableView[K, V]) Remove(k K) {
+	v.remove(k)
+}
+
+// Keys returns the keys of this view.
+func (v *tableView[K, V]) Keys() []K {
+	return v.cells().Keys()
+}
+
+// Values returns the values of this view.
+func (v *tableView[K, V]) Values() []V {
+	return v.cells().Values()
+}
+
+// Size returns the number of cells in this view.
+func (v *tableView[K, V]) Size() int {
+	return v.cells().Size()
+}
+
+// Entries returns the key/value pairs of this view.
+func (v *tableView[K, V]) Entries() []*Entry[K, V] {
+	return v.cells().Entries()
+}
+
+// Iterator returns an iterator over the entries of this view.
+func (v *tableView[K, V]) Iterator() Iterator[*Entry[K, V]] {
+	return IteratorFromSlice(v.Entries())
+}
+
+func copyDictionary[K comparable, T any](d Dictionary[K, T]) Dictionary[K, T] {
+	c := make(Dictionary[K, T], len(d))
+	for k, v := range d {
+		c[k] = v
+	}
+	return c
+}
@@ -0,0 +1,124 @@
+package collections
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func salesTable() *Table[string, int, float64] {
+	t := NewTable[string, int, float64]()
+	t.Put("north", 2023, 10)
+	t.Put("north", 2024, 12)
+	t.Put("south", 2024, 7)
+	t.Put("east", 2022, 3)
+	return t
+}
+
+func TestTable_Put(t *testing.T) {
+	table := salesTable()
+	table.Put("north", 2024, 15)
+
+	assert.Equal(t, 4, table.Size())
+	v, err := table.Get("north", 2024)
+	assert.NoError(t, err)
+	assert.Equal(t, 15.0, v)
+
+	_, err = table.Get("south", 2023)
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+	_, err = table.Get("west", 2023)
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+	assert.Equal(t, -1.0, table.GetOrDefault("west", 2023, -1))
+	assert.True(t, table.Has("east", 2022))
+	assert.False(t, table.Has("east", 2024))
+}
+
+func TestTable_RowAndColumn(t *testing.T) {
+	table := salesTable()
+
+	assert.Equal(t, Dictionary[int, float64]{2023: 10, 2024: 12}, FromEntries(table.Row("north").Entries()))
+	assert.Equal(t, Dictionary[string, float64]{"north": 12, "south": 7}, FromEntries(table.Column(2024).Entries()))
+	assert.Equal(t, 0, table.Row("west").Size())
+	assert.Equal(t, 0, table.Column(2020).Size())
+	assert.ElementsMatch(t, []string{"north", "south", "east"}, table.RowKeys())
+	assert.ElementsMatch(t, []int{2022, 2023, 2024}, table.ColumnKeys())
+}
+
+func TestTable_RowAndColumnViews(t *testing.T) {
+	table := salesTable()
+	north, west, column := table.Row("north"), table.Row("west"), table.Column(2025)
+
+	north.Set(2025, 1)
+	assert.True(t, table.Has("north", 2025))
+	assert.Equal(t, 5, table.Size())
+	v, err := column.Get("north")
+	assert.NoError(t, err)
+	assert.Equal(t, 1.0, v)
+
+	west.Set(2025, 2)
+	assert.True(t, table.HasRow("west"))
+	assert.ElementsMatch(t, []string{"north", "west"}, column.Keys())
+
+	column.Remove("north")
+	assert.False(t, north.Has(2025))
+	assert.Equal(t, 2, north.Size())
+	assert.Equal(t, 5, table.Size())
+
+	west.Remove(2025)
+	assert.False(t, table.HasRow("west"))
+	assert.False(t, table.HasColumn(2025))
+	assert.Equal(t, 0, column.Size())
+
+	table.RemoveRow("north")
+	assert.Empty(t, north.Entries())
+	table.Put("north", 2020, 4)
+	assert.Equal(t, []float64{4}, north.Values())
+}
+
+func TestTable_Remove(t *testing.T) {
+	table := salesTable()
+
+	assert.True(t, table.Remove("east", 2022))
+	assert.False(t, table.Remove("east", 2022))
+	assert.False(t, table.HasRow("east"))
+	assert.False(t, table.HasColumn(2022))
+
+	table.RemoveColumn(2024)
+	assert.Equal(t, 1, table.Size())
+	assert.Equal(t, []string{"north"}, table.RowKeys())
+
+	table.RemoveRow("north")
+	assert.True(t, table.IsEmpty())
+	assert.Empty(t, table.ColumnKeys())
+
+	table = salesTable()
+	table.Clear()
+	assert.True(t, table.IsEmpty())
+	assert.Empty(t, table.Cells())
+}
+
+func TestTable_Transpose(t *testing.T) {
+	table := salesTable()
+	transposed := table.Transpose()
+
+	assert.Equal(t, 4, transposed.Size())
+	assert.Equal(t, Dictionary[string, float64]{"north": 12, "south": 7}, FromEntries(transposed.Row(2024).Entries()))
+	assert.Equal(t, Dictionary[int, float64]{2023: 10, 2024: 12}, FromEntries(transposed.Column("north").Entries()))
+
+	transposed.Put(2024, "west", 1)
+	assert.False(t, table.Has("west", 2024))
+}
+
+func TestTable_Iterator(t *testing.T) {
+	table := salesTable()
+
+	var cells []string
+	total := 0.0
+	for it := table.Iterator(); it.HasNext(); {
+		c := it.Next()
+		cells = append(cells, c.Row())
+		total += c.Value()
+		assert.Equal(t, c.Value(), table.GetOrDefault(c.Row(), c.Column(), -1))
+	}
+	assert.ElementsMatch(t, []string{"north", "north", "south", "east"}, cells)
+	assert.Equal(t, 32.0, total)
+}
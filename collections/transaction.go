@@ -0,0 +1,304 @@
+package collections
+
+import "errors"
+
+type (
+
+	// The transactional collections work on snapshots: Begin, Savepoint and Commit each copy the whole collection, and
+	// every committed transaction keeps a copy of the collection to undo it, up to the history limit. They are meant
+	// for collections small enough to copy on every change.
+
+	// TransactionalList is an ArrayList whose changes are made through transactions, with undo and redo of the
+	// committed transactions.
+	TransactionalList[T any] struct {
+		history[*ArrayList[T]]
+	}
+
+	// TransactionalSet is a ValueSet whose changes are made through transactions, with undo and redo of the
+	// committed transactions.
+	TransactionalSet[T comparable] struct {
+		history[*ValueSet[T]]
+	}
+
+	// TransactionalDictionary is a Dictionary whose changes are made through transactions, with undo and redo of the
+	// committed transactions.
+	TransactionalDictionary[K comparable, T any] struct {
+		history[Dictionary[K, T]]
+	}
+
+	// ListTransaction is a working copy of a TransactionalList, its changes are applied to the list on Commit.
+	ListTransaction[T any] struct {
+		*ArrayList[T]
+		transaction[*ArrayList[T]]
+	}
+
+	// SetTransaction is a working copy of a TransactionalSet, its changes are applied to the set on Commit.
+	SetTransaction[T comparable] struct {
+		*ValueSet[T]
+		transaction[*ValueSet[T]]
+	}
+
+	// DictionaryTransaction is a working copy of a TransactionalDictionary, its changes are applied to the dictionary on Commit.
+	DictionaryTransaction[K comparable, T any] struct {
+		Dictionary[K, T]
+		transaction[Dictionary[K, T]]
+	}
+
+	// Savepoint identifies a state of a transaction that can be restored with RollbackTo.
+	Savepoint int
+
+	// TransactionOption configures a transactional collection.
+	TransactionOption func(*transactionOptions)
+
+	transactionOptions struct {
+		historyLimit int
+	}
+
+	history[C any] struct {
+		current C
+		clone   func(C) C
+		restore func(dst, src C)
+		limit   int
+		version int
+		undo    []C
+		redo    []C
+	}
+
+	transaction[C any] struct {
+		owner      *history[C]
+		working    C
+		version    int
+		savepoints []C
+		done       bool
+	}
+)
+
+// DefaultHistoryLimit is the number of committed transactions that can be undone by default.
+const DefaultHistoryLimit = 100
+
+var (
+	ErrTransactionDone     = errors.New("transaction already committed or rolled back")
+	ErrTransactionConflict = errors.New("collection changed since the transaction began")
+	ErrInvalidSavepoint    = errors.New("invalid savepoint")
+)
+
+// WithHistoryLimit sets the number of committed transactions that can be undone, DefaultHistoryLimit by default. The
+// oldest transactions are forgotten beyond the limit, and a limit of 0 disables undo and redo.
+func WithHistoryLimit(n int) TransactionOption {
+	return func(o *transactionOptions) {
+		o.historyLimit = n
+	}
+}
+
+func historyLimit(opts []TransactionOption) int {
+	o := transactionOptions{historyLimit: DefaultHistoryLimit}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.historyLimit < 0 {
+		return 0
+	}
+	return o.historyLimit
+}
+
+// NewTransactionalList returns a TransactionalList that manages the list, the list must not be modified directly
+// while it is managed.
+func NewTransactionalList[T any](list *ArrayList[T], opts ...TransactionOption) *TransactionalList[T] {
+	return &TransactionalList[T]{
+		history: history[*ArrayList[T]]{
+			current: list,
+			limit:   historyLimit(opts),
+			clone: func(l *ArrayList[T]) *ArrayList[T] {
+				return NewArrayListWithElements(l.elements)
+			},
+			restore: func(dst, src *ArrayList[T]) {
+				dst.elements = NewArrayListWithElements(src.elements).elements
+			},
+		},
+	}
+}
+
+// List returns the list with the committed changes.
+func (t *TransactionalList[T]) List() *ArrayList[T] {
+	return t.current
+}
+
+// Begin starts a transaction over a copy of the list.
+func (t *TransactionalList[T]) Begin() *ListTransaction[T] {
+	tx := t.begin()
+	return &ListTransaction[T]{ArrayList: tx.working, transaction: tx}
+}
+
+// NewTransactionalSet returns a TransactionalSet that manages the set, the set must not be modified directly while
+// it is managed.
+func NewTransactionalSet[T comparable](set *ValueSet[T], opts ...TransactionOption) *TransactionalSet[T] {
+	return &TransactionalSet[T]{
+		history: history[*ValueSet[T]]{
+			current: set,
+			limit:   historyLimit(opts),
+			clone: func(s *ValueSet[T]) *ValueSet[T] {
+				return NewValueSetWithElements(s.ToArray())
+			},
+			restore: func(dst, src *ValueSet[T]) {
+				dst.elements = NewValueSetWithElements(src.ToArray()).elements
+			},
+		},
+	}
+}
+
+// Set returns the set with the committed changes.
+func (t *TransactionalSet[T]) Set() *ValueSet[T] {
+	return t.current
+}
+
+// Begin starts a transaction over a copy of the set.
+func (t *TransactionalSet[T]) Begin() *SetTransaction[T] {
+	tx := t.begin()
+	return &SetTransaction[T]{ValueSet: tx.working, transaction: tx}
+}
+
+// NewTransactionalDictionary returns a TransactionalDictionary that manages the dictionary, the dictionary must not
+// be modified directly while it is managed.
+func NewTransactionalDictionary[K comparable, T any](d Dictionary[K, T], opts ...TransactionOption) *TransactionalDictionary[K, T] {
+	return &TransactionalDictionary[K, T]{
+		history: history[Dictionary[K, T]]{
+			current: d,
+			limit:   historyLimit(opts),
+			clone: func(d Dictionary[K, T]) Dictionary[K, T] {
+				c := make(Dictionary[K, T], len(d))
+				c.Merge(d)
+				return c
+			},
+			restore: func(dst, src Dictionary[K, T]) {
+				for k := range dst {
+					delete(dst, k)
+				}
+				dst.Merge(src)
+			},
+		},
+	}
+}
+
+// Dictionary returns the dictionary with the committed changes.
+func (t *TransactionalDictionary[K, T]) Dictionary() Dictionary[K, T] {
+	return t.current
+}
+
+// Begin starts a transaction over a copy of the dictionary.
+func (t *TransactionalDictionary[K, T]) Begin() *DictionaryTransaction[K, T] {
+	tx := t.begin()
+	return &DictionaryTransaction[K, T]{Dictionary: tx.working, transaction: tx}
+}
+
+func (h *history[C]) begin() transaction[C] {
+	return transaction[C]{owner: h, working: h.clone(h.current), version: h.version}
+}
+
+// CanUndo returns true if there is a committed transaction to undo.
+func (h *history[C]) CanUndo() bool {
+	return len(h.undo) > 0
+}
+
+// CanRedo returns true if there is an undone transaction to redo.
+func (h *history[C]) CanRedo() bool {
+	return len(h.redo) > 0
+}
+
+// Undo reverts the last committed transaction, it returns false if there is nothing to undo. Transactions begun
+// before the undo can no longer be committed.
+func (h *history[C]) Undo() bool {
+	if len(h.undo) == 0 {
+		return false
+	}
+	h.redo = append(h.redo, h.clone(h.current))
+	h.restore(h.current, h.undo[len(h.undo)-1])
+	h.undo = pop(h.undo)
+	h.version++
+	return true
+}
+
+// Redo applies again the last undone transaction, it returns false if there is nothing to redo. Transactions begun
+// before the redo can no longer be committed.
+func (h *history[C]) Redo() bool {
+	if len(h.redo) == 0 {
+		return false
+	}
+	h.pushUndo()
+	h.restore(h.current, h.redo[len(h.redo)-1])
+	h.redo = pop(h.redo)
+	h.version++
+	return true
+}
+
+func (h *history[C]) commit(working C) {
+	h.pushUndo()
+	h.redo = nil
+	h.restore(h.current, working)
+	h.version++
+}
+
+// pushUndo records a copy of the current state to undo, forgetting the oldest one beyond the limit.
+func (h *history[C]) pushUndo() {
+	if h.limit == 0 {
+		return
+	}
+	if len(h.undo) == h.limit {
+		var zero C
+		copy(h.undo, h.undo[1:])
+		h.undo[len(h.undo)-1] = zero
+		h.undo = h.undo[:len(h.undo)-1]
+	}
+	h.undo = append(h.undo, h.clone(h.current))
+}
+
+// pop removes the last state of a stack, clearing it so it can be released.
+func pop[C any](stack []C) []C {
+	var zero C
+	stack[len(stack)-1] = zero
+	return stack[:len(stack)-1]
+}
+
+// Commit applies the changes of the transaction to the collection. It returns ErrTransactionConflict if another
+// transaction was committed, undone or redone since this one began, in which case the transaction is rolled back.
+func (t *transaction[C]) Commit() error {
+	if t.done {
+		return ErrTransactionDone
+	}
+	t.done = true
+	if t.version != t.owner.version {
+		return ErrTransactionConflict
+	}
+	t.owner.commit(t.working)
+	return nil
+}
+
+// Rollback discards the changes of the transaction.
+func (t *transaction[C]) Rollback() error {
+	if t.done {
+		return ErrTransactionDone
+	}
+	t.done = true
+	return nil
+}
+
+// Savepoint records a copy of the current state of the transaction so it can be restored with RollbackTo.
+func (t *transaction[C]) Savepoint() (Savepoint, error) {
+	if t.done {
+		return 0, ErrTransactionDone
+	}
+	t.savepoints = append(t.savepoints, t.owner.clone(t.working))
+	return Savepoint(len(t.savepoints) - 1), nil
+}
+
+// RollbackTo discards the changes made since the savepoint was recorded, along with any later savepoint.
+func (t *transaction[C]) RollbackTo(s Savepoint) error {
+	if t.done {
+		return ErrTransactionDone
+	}
+	if s < 0 || int(s) >= len(t.savepoints) {
+		return ErrInvalidSavepoint
+	}
+	t.owner.restore(t.working, t.savepoints[s])
+	t.savepoints = t.savepoints[:s+1]
+	return nil
+}
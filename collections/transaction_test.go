@@ -0,0 +1,156 @@
+package collections
+
+import (
+	"github.com/stretchr/testify/assert"
+	"sort"
+	"testing"
+)
+
+func TestTransactionalList_Commit(t *testing.T) {
+	list := NewArrayListWithElements([]int{1, 2})
+	tl := NewTransactionalList(list)
+
+	tx := tl.Begin()
+	tx.Add(3)
+	tx.RemoveAt(0)
+	assert.Equal(t, []int{1, 2}, list.ToArray())
+
+	assert.NoError(t, tx.Commit())
+	assert.Equal(t, []int{2, 3}, list.ToArray())
+	assert.ErrorIs(t, tx.Commit(), ErrTransactionDone)
+}
+
+func TestTransactionalList_Rollback(t *testing.T) {
+	list := NewArrayListWithElements([]int{1, 2})
+	tl := NewTransactionalList(list)
+
+	tx := tl.Begin()
+	tx.Clear()
+	assert.NoError(t, tx.Rollback())
+
+	assert.Equal(t, []int{1, 2}, tl.List().ToArray())
+	assert.ErrorIs(t, tx.Rollback(), ErrTransactionDone)
+	assert.False(t, tl.CanUndo())
+}
+
+func TestTransactionalList_Conflict(t *testing.T) {
+	tl := NewTransactionalList(NewArrayList[int]())
+
+	tx1 := tl.Begin()
+	tx2 := tl.Begin()
+	tx1.Add(1)
+	tx2.Add(2)
+
+	assert.NoError(t, tx1.Commit())
+	assert.ErrorIs(t, tx2.Commit(), ErrTransactionConflict)
+	assert.Equal(t, []int{1}, tl.List().ToArray())
+}
+
+func TestTransactionalList_Savepoint(t *testing.T) {
+	tl := NewTransactionalList(NewArrayList[int]())
+
+	tx := tl.Begin()
+	tx.Add(1)
+	s1, err := tx.Savepoint()
+	assert.NoError(t, err)
+	tx.Add(2)
+	s2, _ := tx.Savepoint()
+	tx.Add(3)
+
+	assert.NoError(t, tx.RollbackTo(s2))
+	assert.Equal(t, []int{1, 2}, tx.ToArray())
+	assert.NoError(t, tx.RollbackTo(s1))
+	assert.Equal(t, []int{1}, tx.ToArray())
+	assert.ErrorIs(t, tx.RollbackTo(s2), ErrInvalidSavepoint)
+
+	tx.Add(4)
+	assert.NoError(t, tx.Commit())
+	assert.Equal(t, []int{1, 4}, tl.List().ToArray())
+	_, err = tx.Savepoint()
+	assert.ErrorIs(t, err, ErrTransactionDone)
+}
+
+func TestTransactionalList_UndoRedo(t *testing.T) {
+	tl := NewTransactionalList(NewArrayList[int]())
+	for i := 1; i <= 2; i++ {
+		tx := tl.Begin()
+		tx.Add(i)
+		assert.NoError(t, tx.Commit())
+	}
+
+	assert.True(t, tl.Undo())
+	assert.Equal(t, []int{1}, tl.List().ToArray())
+	assert.True(t, tl.Undo())
+	assert.Equal(t, []int{}, tl.List().ToArray())
+	assert.False(t, tl.Undo())
+
+	assert.True(t, tl.Redo())
+	assert.Equal(t, []int{1}, tl.List().ToArray())
+
+	tx := tl.Begin()
+	tx.Add(3)
+	assert.NoError(t, tx.Commit())
+	assert.False(t, tl.CanRedo())
+	assert.Equal(t, []int{1, 3}, tl.List().ToArray())
+}
+
+func TestTransactionalSet(t *testing.T) {
+	set := NewValueSetWithElements([]int{1})
+	ts := NewTransactionalSet(set)
+
+	tx := ts.Begin()
+	tx.Add(2)
+	tx.Remove(1)
+	assert.True(t, set.Contains(1))
+	assert.NoError(t, tx.Commit())
+
+	actual := set.ToArray()
+	sort.Ints(actual)
+	assert.Equal(t, []int{2}, actual)
+
+	assert.True(t, ts.Undo())
+	assert.Equal(t, []int{1}, ts.Set().ToArray())
+}
+
+func TestTransactionalDictionary(t *testing.T) {
+	d := Dictionary[string, int]{"a": 1}
+	td := NewTransactionalDictionary(d)
+
+	tx := td.Begin()
+	tx.Set("b", 2)
+	tx.Remove("a")
+	assert.Equal(t, Dictionary[string, int]{"a": 1}, d)
+	assert.NoError(t, tx.Commit())
+	assert.Equal(t, Dictionary[string, int]{"b": 2}, d)
+
+	assert.True(t, td.Undo())
+	assert.Equal(t, Dictionary[string, int]{"a": 1}, td.Dictionary())
+	assert.True(t, td.Redo())
+	assert.Equal(t, Dictionary[string, int]{"b": 2}, d)
+}
+
+func TestTransactionalList_HistoryLimit(t *testing.T) {
+	tl := NewTransactionalList(NewArrayList[int](), WithHistoryLimit(2))
+	for i := 1; i <= 4; i++ {
+		tx := tl.Begin()
+		tx.Add(i)
+		assert.NoError(t, tx.Commit())
+	}
+
+	assert.True(t, tl.Undo())
+	assert.True(t, tl.Undo())
+	assert.False(t, tl.Undo())
+	assert.Equal(t, []int{1, 2}, tl.List().ToArray())
+
+	assert.True(t, tl.Redo())
+	assert.True(t, tl.Redo())
+	assert.False(t, tl.Redo())
+	assert.Equal(t, []int{1, 2, 3, 4}, tl.List().ToArray())
+
+	tl = NewTransactionalList(NewArrayList[int](), WithHistoryLimit(0))
+	tx := tl.Begin()
+	tx.Add(1)
+	assert.NoError(t, tx.Commit())
+	assert.False(t, tl.CanUndo())
+	assert.Equal(t, []int{1}, tl.List().ToArray())
+}
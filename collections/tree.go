@@ -0,0 +1,283 @@
+package collections
+
+import (
+	"errors"
+)
+
+type (
+
+	// Tree is a node of an n-ary tree holding a value, linked to its parent and its ordered children. Every node is
+	// the root of its own subtree.
+	Tree[T any] struct {
+		value    T
+		parent   *Tree[T]
+		children []*Tree[T]
+	}
+)
+
+var (
+	ErrParentNotFound = errors.New("parent record not found")
+	ErrDuplicateNode  = errors.New("duplicated record id")
+	ErrTreeCycle      = errors.New("records form a cycle")
+)
+
+// NewTree returns a new tree with a single node holding the value.
+func NewTree[T any](value T) *Tree[T] {
+	return &Tree[T]{value: value}
+}
+
+// BuildTrees links flat records into trees and returns their roots. The id function returns the id of a record and
+// the parent function returns the id of its parent, or false if the record is a root. The children keep the order of
+// the records. It returns ErrDuplicateNode if two records have the same id, ErrParentNotFound if a parent id does
+// not match any record and ErrTreeCycle if some records are their own ancestors.
+func BuildTrees[T any, ID comparable](records []T, id func(T) ID, parent func(T) (ID, bool)) ([]*Tree[T], error) {
+	nodes := make(Dictionary[ID, *Tree[T]], len(records))
+	for _, r := range records {
+		if !nodes.PutIfAbsent(id(r), NewTree(r)) {
+			return nil, ErrDuplicateNode
+		}
+	}
+
+	var roots []*Tree[T]
+	for _, r := range records {
+		node := nodes[id(r)]
+		parentID, ok := parent(r)
+		if !ok {
+			roots = append(roots, node)
+			continue
+		}
+		p, ok := nodes[parentID]
+		if !ok {
+			return nil, ErrParentNotFound
+		}
+		node.parent = p
+		p.children = append(p.children, node)
+	}
+
+	linked := 0
+	for _, root := range roots {
+		linked += root.Size()
+	}
+	if linked < len(records) {
+		return nil, ErrTreeCycle
+	}
+	return roots, nil
+}
+
+// FlattenTrees returns a record for every node of the trees in pre-order, built by fn from the value of the node and
+// its parent, which is nil for the roots.
+func FlattenTrees[T any, R any](trees []*Tree[T], fn func(value T, parent *Tree[T]) R) []R {
+	var records []R
+	for _, t := range trees {
+		t.walkPreOrder(func(n *Tree[T]) {
+			records = append(records, fn(n.value, n.parent))
+		})
+	}
+	return records
+}
+
+// MapTree returns a new tree with the same shape as the tree and the values transformed by fn.
+func MapTree[T any, R any](t *Tree[T], fn func(T) R) *Tree[R] {
+	mapped := NewTree(fn(t.value))
+	mapped.children = make([]*Tree[R], len(t.children))
+	for i, child := range t.children {
+		mapped.children[i] = MapTree(child, fn)
+		mapped.children[i].parent = mapped
+	}
+	return mapped
+}
+
+// Value returns the value of the node.
+func (t *Tree[T]) Value() T {
+	return t.value
+}
+
+// SetValue replaces the value of the node.
+func (t *Tree[T]) SetValue(value T) {
+	t.value = value
+}
+
+// Parent returns the parent of the node, or nil if the node is a root.
+func (t *Tree[T]) Parent() *Tree[T] {
+	return t.parent
+}
+
+// Children returns the children of the node in order.
+func (t *Tree[T]) Children() []*Tree[T] {
+	children := make([]*Tree[T], len(t.children))
+	copy(children, t.children)
+	return children
+}
+
+// Root returns the root of the tree containing the node.
+func (t *Tree[T]) Root() *Tree[T] {
+	root := t
+	for root.parent != nil {
+		root = root.parent
+	}
+	return root
+}
+
+// IsRoot returns true if the node has no parent.
+func (t *Tree[T]) IsRoot() bool {
+	return t.parent == nil
+}
+
+// IsLeaf returns true if the node has no children.
+func (t *Tree[T]) IsLeaf() bool {
+	return len(t.children) == 0
+}
+
+// AddChild adds a new node holding the value as the last child of the node and returns it.
+func (t *Tree[T]) AddChild(value T) *Tree[T] {
+	child := &Tree[T]{value: value, parent: t}
+	t.children = append(t.children, child)
+	return child
+}
+
+// AddSubtree moves the subtree to be the last child of the node, detaching it from its previous parent. It returns
+// false if the subtree contains the node.
+func (t *Tree[T]) AddSubtree(subtree *Tree[T]) bool {
+	for n := t; n != nil; n = n.parent {
+		if n == subtree {
+			return false
+		}
+	}
+	subtree.Remove()
+	subtree.parent = t
+	t.children = append(t.children, subtree)
+	return true
+}
+
+// Remove detaches the subtree rooted at the node from its parent, it returns false if the node is a root.
+func (t *Tree[T]) Remove() bool {
+	if t.parent == nil {
+		return false
+	}
+	return t.parent.RemoveChild(t)
+}
+
+// RemoveChild detaches the subtree rooted at the child, it returns false if the node is not a child of this node.
+func (t *Tree[T]) RemoveChild(child *Tree[T]) bool {
+	for i, c := range t.children {
+		if c == child {
+			t.children = append(t.children[:i], t.children[i+1:]...)
+			child.parent = nil
+			return true
+		}
+	}
+	return false
+}
+
+// Depth returns the number of edges between the node and the root, the depth of a root is 0.
+func (t *Tree[T]) Depth() int {
+	depth := 0
+	for n := t.parent; n != nil; n = n.parent {
+		depth++
+	}
+	return depth
+}
+
+// Height returns the number of edges of the longest path from the node to a leaf, the height of a leaf is 0.
+func (t *Tree[T]) Height() int {
+	height := 0
+	for _, child := range t.children {
+		if h := child.Height() + 1; h > height {
+			height = h
+		}
+	}
+	return height
+}
+
+// Size returns the number of nodes in the subtree rooted at the node.
+func (t *Tree[T]) Size() int {
+	size := 0
+	t.walkPreOrder(func(*Tree[T]) {
+		size++
+	})
+	return size
+}
+
+// Find returns the first node of the subtree in pre-order whose value satisfies the predicate, or nil if there is
+// none.
+func (t *Tree[T]) Find(predicate Predicate[T]) *Tree[T] {
+	if predicate(t.value) {
+		return t
+	}
+	for _, child := range t.children {
+		if found := child.Find(predicate); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// Path returns the values from the root down to the node.
+func (t *Tree[T]) Path() []T {
+	path := make([]T, t.Depth()+1)
+	for i, n := len(path)-1, t; n != nil; i, n = i-1, n.parent {
+		path[i] = n.value
+	}
+	return path
+}
+
+// PathTo returns the values from the node down to the first node of the subtree in pre-order whose value satisfies
+// the predicate, or nil if there is none.
+func (t *Tree[T]) PathTo(predicate Predicate[T]) []T {
+	found := t.Find(predicate)
+	if found == nil {
+		return nil
+	}
+	return found.Path()[t.Depth():]
+}
+
+// PreOrder returns an iterator over the values of the subtree visiting every node before its children.
+func (t *Tree[T]) PreOrder() Iterator[T] {
+	var values []T
+	t.walkPreOrder(func(n *Tree[T]) {
+		values = append(values, n.value)
+	})
+	return IteratorFromSlice(values)
+}
+
+// PostOrder returns an iterator over the values of the subtree visiting every node after its children.
+func (t *Tree[T]) PostOrder() Iterator[T] {
+	var values []T
+	t.walkPostOrder(func(n *Tree[T]) {
+		values = append(values, n.value)
+	})
+	return IteratorFromSlice(values)
+}
+
+// LevelOrder returns an iterator over the values of the subtree visiting the nodes level by level.
+func (t *Tree[T]) LevelOrder() Iterator[T] {
+	var values []T
+	for level := []*Tree[T]{t}; len(level) > 0; {
+		var next []*Tree[T]
+		for _, n := range level {
+			values = append(values, n.value)
+			next = append(next, n.children...)
+		}
+		level = next
+	}
+	return IteratorFromSlice(values)
+}
+
+// Iterator returns an iterator over the values of the subtree in pre-order.
+func (t *Tree[T]) Iterator() Iterator[T] {
+	return t.PreOrder()
+}
+
+func (t *Tree[T]) walkPreOrder(fn func(*Tree[T])) {
+	fn(t)
+	for _, child := range t.children {
+		child.walkPreOrder(fn)
+	}
+}
+
+func (t *Tree[T]) walkPostOrder(fn func(*Tree[T])) {
+	for _, child := range t.children {
+		child.walkPostOrder(fn)
+	}
+	fn(t)
+}
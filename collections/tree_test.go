@@ -0,0 +1,188 @@
+package collections
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+type category struct {
+	id       int
+	parentID int
+	name     string
+}
+
+// sampleTree builds
+//
+//	a
+//	├── b
+//	│   ├── d
+//	│   └── e
+//	└── c
+//	    └── f
+func sampleTree() *Tree[string] {
+	a := NewTree("a")
+	b := a.AddChild("b")
+	c := a.AddChild("c")
+	b.AddChild("d")
+	b.AddChild("e")
+	c.AddChild("f")
+	return a
+}
+
+func values[T any](it Iterator[T]) []T {
+	var values []T
+	for it.HasNext() {
+		values = append(values, it.Next())
+	}
+	return values
+}
+
+func TestTree_Traversals(t *testing.T) {
+	tree := sampleTree()
+
+	assert.Equal(t, []string{"a", "b", "d", "e", "c", "f"}, values(tree.PreOrder()))
+	assert.Equal(t, []string{"d", "e", "b", "f", "c", "a"}, values(tree.PostOrder()))
+	assert.Equal(t, []string{"a", "b", "c", "d", "e", "f"}, values(tree.LevelOrder()))
+	assert.Equal(t, []string{"a", "b", "d", "e", "c", "f"}, values(tree.Iterator()))
+	assert.Equal(t, 6, tree.Size())
+	assert.Equal(t, 2, tree.Height())
+}
+
+func TestTree_Find(t *testing.T) {
+	tree := sampleTree()
+
+	e := tree.Find(func(s string) bool { return s == "e" })
+	assert.NotNil(t, e)
+	assert.Equal(t, 2, e.Depth())
+	assert.Equal(t, "b", e.Parent().Value())
+	assert.Equal(t, tree, e.Root())
+	assert.True(t, e.IsLeaf())
+	assert.Equal(t, []string{"a", "b", "e"}, e.Path())
+
+	assert.Nil(t, tree.Find(func(s string) bool { return s == "z" }))
+}
+
+func TestTree_PathTo(t *testing.T) {
+	tree := sampleTree()
+	c := tree.Children()[1]
+
+	assert.Equal(t, []string{"a", "c", "f"}, tree.PathTo(func(s string) bool { return s == "f" }))
+	assert.Equal(t, []string{"c", "f"}, c.PathTo(func(s string) bool { return s == "f" }))
+	assert.Equal(t, []string{"a"}, tree.PathTo(func(s string) bool { return s == "a" }))
+	assert.Nil(t, c.PathTo(func(s string) bool { return s == "d" }))
+}
+
+func TestTree_Remove(t *testing.T) {
+	tree := sampleTree()
+	b := tree.Children()[0]
+
+	assert.True(t, b.Remove())
+	assert.False(t, b.Remove())
+	assert.True(t, b.IsRoot())
+	assert.False(t, tree.RemoveChild(b))
+	assert.Equal(t, []string{"a", "c", "f"}, values(tree.PreOrder()))
+	assert.Equal(t, []string{"b", "d", "e"}, values(b.PreOrder()))
+	assert.False(t, tree.Remove())
+}
+
+func TestTree_AddSubtree(t *testing.T) {
+	tree := sampleTree()
+	b, c := tree.Children()[0], tree.Children()[1]
+
+	assert.True(t, c.AddSubtree(b))
+	assert.Equal(t, []string{"a", "c", "f", "b", "d", "e"}, values(tree.PreOrder()))
+	assert.Equal(t, c, b.Parent())
+	assert.False(t, b.AddSubtree(tree))
+	assert.False(t, b.AddSubtree(b))
+}
+
+func TestMapTree(t *testing.T) {
+	tree := sampleTree()
+
+	mapped := MapTree(tree, strings.ToUpper)
+	assert.Equal(t, []string{"A", "B", "D", "E", "C", "F"}, values(mapped.PreOrder()))
+	assert.Equal(t, "B", mapped.Find(func(s string) bool { return s == "D" }).Parent().Value())
+	assert.Equal(t, []string{"a", "b", "d", "e", "c", "f"}, values(tree.PreOrder()))
+}
+
+func TestBuildTrees(t *testing.T) {
+	id := func(c category) int { return c.id }
+	parent := func(c category) (int, bool) { return c.parentID, c.parentID != 0 }
+
+	tests := []struct {
+		name          string
+		records       []category
+		expectedRoots []string
+		expectedErr   error
+	}{
+		{
+			name: "Forest",
+			records: []category{
+				{id: 3, parentID: 1, name: "phones"},
+				{id: 1, name: "electronics"},
+				{id: 4, parentID: 3, name: "android"},
+				{id: 2, name: "books"},
+				{id: 5, parentID: 1, name: "laptops"},
+			},
+			expectedRoots: []string{"electronics", "books"},
+		},
+		{
+			name:        "Duplicated id",
+			records:     []category{{id: 1, name: "a"}, {id: 1, name: "b"}},
+			expectedErr: ErrDuplicateNode,
+		},
+		{
+			name:        "Missing parent",
+			records:     []category{{id: 1, name: "a"}, {id: 2, parentID: 3, name: "b"}},
+			expectedErr: ErrParentNotFound,
+		},
+		{
+			name:        "Cycle",
+			records:     []category{{id: 1, name: "a"}, {id: 2, parentID: 3, name: "b"}, {id: 3, parentID: 2, name: "c"}},
+			expectedErr: ErrTreeCycle,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roots, err := BuildTrees(tt.records, id, parent)
+			assert.ErrorIs(t, err, tt.expectedErr)
+
+			var names []string
+			for _, root := range roots {
+				names = append(names, root.Value().name)
+			}
+			assert.Equal(t, tt.expectedRoots, names)
+		})
+	}
+}
+
+func TestFlattenTrees(t *testing.T) {
+	records := []category{
+		{id: 1, name: "electronics"},
+		{id: 2, parentID: 1, name: "phones"},
+		{id: 3, parentID: 1, name: "laptops"},
+		{id: 4, name: "books"},
+	}
+	roots, err := BuildTrees(records, func(c category) int { return c.id }, func(c category) (int, bool) {
+		return c.parentID, c.parentID != 0
+	})
+	assert.NoError(t, err)
+
+	roots[1].AddSubtree(roots[0].Children()[1])
+
+	flat := FlattenTrees(roots, func(c category, parent *Tree[category]) category {
+		c.parentID = 0
+		if parent != nil {
+			c.parentID = parent.Value().id
+		}
+		return c
+	})
+	assert.Equal(t, []category{
+		{id: 1, name: "electronics"},
+		{id: 2, parentID: 1, name: "phones"},
+		{id: 4, name: "books"},
+		{id: 3, parentID: 4, name: "laptops"},
+	}, flat)
+}
@@ -0,0 +1,281 @@
+package collections
+
+import (
+	"sort"
+	"strings"
+)
+
+type (
+
+	// Trie is a Map keyed by strings backed by a radix tree, whose edges hold the common prefixes of the keys. The
+	// entries are iterated in lexicographic order of their keys.
+	Trie[V any] struct {
+		root *trieNode[V]
+		size int
+	}
+
+	trieNode[V any] struct {
+		prefix   string
+		children []*trieNode[V]
+		value    V
+		hasValue bool
+	}
+)
+
+var _ Map[string, any] = (*Trie[any])(nil)
+
+// NewTrie returns a new empty Trie.
+func NewTrie[V any]() *Trie[V] {
+	return &Trie[V]{root: &trieNode[V]{}}
+}
+
+// Insert sets the value of the key, it returns true if the key did not exist.
+func (t *Trie[V]) Insert(key string, value V) bool {
+	n := t.root
+	for {
+		if key == "" {
+			added := !n.hasValue
+			n.value, n.hasValue = value, true
+			if added {
+				t.size++
+			}
+			return added
+		}
+
+		i, child := n.child(key[0])
+		if child == nil {
+			n.children = insertAt(n.children, i, &trieNode[V]{prefix: key, value: value, hasValue: true})
+			t.size++
+			return true
+		}
+
+		common := commonPrefix(child.prefix, key)
+		if common < len(child.prefix) {
+			// Split the edge at the end of the common prefix.
+			child.prefix = child.prefix[common:]
+			child = &trieNode[V]{prefix: key[:common], children: []*trieNode[V]{child}}
+			n.children[i] = child
+		}
+		n, key = child, key[common:]
+	}
+}
+
+// Get returns the value of the key, or ErrKeyNotFound if the key does not exist.
+func (t *Trie[V]) Get(key string) (V, error) {
+	n := t.find(key)
+	if n == nil || !n.hasValue {
+		var zero V
+		return zero, ErrKeyNotFound
+	}
+	return n.value, nil
+}
+
+// GetOrDefault returns the value of the key or the default value if the key does not exist.
+func (t *Trie[V]) GetOrDefault(key string, value V) V {
+	if v, err := t.Get(key); err == nil {
+		return v
+	}
+	return value
+}
+
+// Set sets the value of the key.
+func (t *Trie[V]) Set(key string, value V) {
+	t.Insert(key, value)
+}
+
+// Has returns true if the key exists.
+func (t *Trie[V]) Has(key string) bool {
+	n := t.find(key)
+	return n != nil && n.hasValue
+}
+
+// Delete removes the key, it returns true if the key existed.
+func (t *Trie[V]) Delete(key string) bool {
+	if !t.root.delete(key) {
+		return false
+	}
+	t.size--
+	return true
+}
+
+// Remove removes the key.
+func (t *Trie[V]) Remove(key string) {
+	t.Delete(key)
+}
+
+// Size returns the number of entries in the trie.
+func (t *Trie[V]) Size() int {
+	return t.size
+}
+
+// IsEmpty returns true if the trie contains no entries.
+func (t *Trie[V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Clear removes all the entries.
+func (t *Trie[V]) Clear() {
+	t.root = &trieNode[V]{}
+	t.size = 0
+}
+
+// Keys returns the keys of the trie in lexicographic order.
+func (t *Trie[V]) Keys() []string {
+	keys := make([]string, 0, t.size)
+	t.Walk(func(key string, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Values returns the values of the trie in the lexicographic order of their keys.
+func (t *Trie[V]) Values() []V {
+	values := make([]V, 0, t.size)
+	t.Walk(func(_ string, value V) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+// Entries returns the key/value pairs of the trie in lexicographic order.
+func (t *Trie[V]) Entries() []*Entry[string, V] {
+	return t.entries("")
+}
+
+// Iterator returns an iterator over the entries of the trie in lexicographic order.
+func (t *Trie[V]) Iterator() Iterator[*Entry[string, V]] {
+	return IteratorFromSlice(t.Entries())
+}
+
+// WithPrefix returns an iterator over the entries whose keys start with the prefix, in lexicographic order.
+func (t *Trie[V]) WithPrefix(prefix string) Iterator[*Entry[string, V]] {
+	return IteratorFromSlice(t.entries(prefix))
+}
+
+// LongestPrefixMatch returns the longest key that is a prefix of s along with its value, or false if no key is a
+// prefix of s.
+func (t *Trie[V]) LongestPrefixMatch(s string) (string, V, bool) {
+	var (
+		key   string
+		value V
+		found bool
+	)
+	n, matched := t.root, 0
+	for {
+		if n.hasValue {
+			key, value, found = s[:matched], n.value, true
+		}
+		if matched == len(s) {
+			return key, value, found
+		}
+		_, child := n.child(s[matched])
+		if child == nil || !strings.HasPrefix(s[matched:], child.prefix) {
+			return key, value, found
+		}
+		n, matched = child, matched+len(child.prefix)
+	}
+}
+
+// Walk calls fn for every entry in lexicographic order of the keys, until fn returns false.
+func (t *Trie[V]) Walk(fn func(key string, value V) bool) {
+	t.WalkPrefix("", fn)
+}
+
+// WalkPrefix calls fn for every entry whose key starts with the prefix in lexicographic order, until fn returns false.
+func (t *Trie[V]) WalkPrefix(prefix string, fn func(key string, value V) bool) {
+	n, path := t.root, ""
+	for len(path) < len(prefix) {
+		_, child := n.child(prefix[len(path)])
+		if child == nil {
+			return
+		}
+		rest := prefix[len(path):]
+		if !strings.HasPrefix(child.prefix, rest) && !strings.HasPrefix(rest, child.prefix) {
+			return
+		}
+		n, path = child, path+child.prefix
+	}
+	n.walk(path, fn)
+}
+
+func (t *Trie[V]) entries(prefix string) []*Entry[string, V] {
+	var entries []*Entry[string, V]
+	t.WalkPrefix(prefix, func(key string, value V) bool {
+		entries = append(entries, &Entry[string, V]{key: key, value: value})
+		return true
+	})
+	return entries
+}
+
+// find returns the node whose path is exactly the key, or nil if there is none.
+func (t *Trie[V]) find(key string) *trieNode[V] {
+	n := t.root
+	for key != "" {
+		_, child := n.child(key[0])
+		if child == nil || !strings.HasPrefix(key, child.prefix) {
+			return nil
+		}
+		n, key = child, key[len(child.prefix):]
+	}
+	return n
+}
+
+// child returns the child whose edge starts with c, or the position where it would be inserted and nil.
+func (n *trieNode[V]) child(c byte) (int, *trieNode[V]) {
+	i := sort.Search(len(n.children), func(i int) bool {
+		return n.children[i].prefix[0] >= c
+	})
+	if i < len(n.children) && n.children[i].prefix[0] == c {
+		return i, n.children[i]
+	}
+	return i, nil
+}
+
+// delete removes the key below the node, merging the nodes left with a single child and no value.
+func (n *trieNode[V]) delete(key string) bool {
+	if key == "" {
+		if !n.hasValue {
+			return false
+		}
+		var zero V
+		n.value, n.hasValue = zero, false
+		return true
+	}
+
+	i, child := n.child(key[0])
+	if child == nil || !strings.HasPrefix(key, child.prefix) || !child.delete(key[len(child.prefix):]) {
+		return false
+	}
+
+	switch {
+	case !child.hasValue && len(child.children) == 0:
+		n.children = append(n.children[:i], n.children[i+1:]...)
+	case !child.hasValue && len(child.children) == 1:
+		grandchild := child.children[0]
+		grandchild.prefix = child.prefix + grandchild.prefix
+		n.children[i] = grandchild
+	}
+	return true
+}
+
+func (n *trieNode[V]) walk(path string, fn func(string, V) bool) bool {
+	if n.hasValue && !fn(path, n.value) {
+		return false
+	}
+	for _, child := range n.children {
+		if !child.walk(path+child.prefix, fn) {
+			return false
+		}
+	}
+	return true
+}
+
+func commonPrefix(a, b string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return i
+}
@@ -0,0 +1,160 @@
+package collections
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+func TestTrie_Insert(t *testing.T) {
+	trie := NewTrie[int]()
+
+	assert.True(t, trie.Insert("team", 1))
+	assert.True(t, trie.Insert("tea", 2))
+	assert.True(t, trie.Insert("ten", 3))
+	assert.True(t, trie.Insert("", 4))
+	assert.False(t, trie.Insert("tea", 5))
+
+	assert.Equal(t, 4, trie.Size())
+	assert.Equal(t, []string{"", "tea", "team", "ten"}, trie.Keys())
+	assert.Equal(t, []int{4, 5, 1, 3}, trie.Values())
+}
+
+func TestTrie_Get(t *testing.T) {
+	trie := NewTrie[int]()
+	trie.Set("team", 1)
+	trie.Set("ten", 2)
+
+	v, err := trie.Get("team")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, v)
+
+	_, err = trie.Get("te")
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+	_, err = trie.Get("teams")
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+	assert.Equal(t, 3, trie.GetOrDefault("tea", 3))
+	assert.True(t, trie.Has("ten"))
+	assert.False(t, trie.Has("t"))
+}
+
+func TestTrie_Delete(t *testing.T) {
+	trie := NewTrie[int]()
+	for i, key := range []string{"romane", "romanus", "romulus", "rubens", "ruber"} {
+		trie.Set(key, i)
+	}
+
+	assert.True(t, trie.Delete("romanus"))
+	assert.False(t, trie.Delete("romanus"))
+	assert.False(t, trie.Delete("rom"))
+	trie.Remove("rubens")
+
+	assert.Equal(t, []string{"romane", "romulus", "ruber"}, trie.Keys())
+	assert.Equal(t, 3, trie.Size())
+
+	trie.Clear()
+	assert.True(t, trie.IsEmpty())
+	assert.Empty(t, trie.Keys())
+}
+
+func TestTrie_LongestPrefixMatch(t *testing.T) {
+	trie := NewTrie[string]()
+	trie.Set("/", "root")
+	trie.Set("/api", "api")
+	trie.Set("/api/users", "users")
+
+	tests := []struct {
+		name          string
+		s             string
+		expectedKey   string
+		expectedValue string
+		expectedFound bool
+	}{
+		{name: "Exact match", s: "/api/users", expectedKey: "/api/users", expectedValue: "users", expectedFound: true},
+		{name: "Longer input", s: "/api/users/1", expectedKey: "/api/users", expectedValue: "users", expectedFound: true},
+		{name: "Partial edge", s: "/api/user", expectedKey: "/api", expectedValue: "api", expectedFound: true},
+		{name: "Root only", s: "/health", expectedKey: "/", expectedValue: "root", expectedFound: true},
+		{name: "No match", s: "health", expectedFound: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, value, found := trie.LongestPrefixMatch(tt.s)
+			assert.Equal(t, tt.expectedFound, found)
+			assert.Equal(t, tt.expectedKey, key)
+			assert.Equal(t, tt.expectedValue, value)
+		})
+	}
+}
+
+func TestTrie_WithPrefix(t *testing.T) {
+	trie := NewTrie[int]()
+	for i, key := range []string{"car", "cart", "carbon", "cat", "dog"} {
+		trie.Set(key, i)
+	}
+
+	tests := []struct {
+		name     string
+		prefix   string
+		expected []string
+	}{
+		{name: "Node prefix", prefix: "car", expected: []string{"car", "carbon", "cart"}},
+		{name: "Edge prefix", prefix: "carb", expected: []string{"carbon"}},
+		{name: "Short prefix", prefix: "c", expected: []string{"car", "carbon", "cart", "cat"}},
+		{name: "Empty prefix", prefix: "", expected: []string{"car", "carbon", "cart", "cat", "dog"}},
+		{name: "No match", prefix: "cab", expected: nil},
+		{name: "Longer than key", prefix: "dogs", expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var actual []string
+			for it := trie.WithPrefix(tt.prefix); it.HasNext(); {
+				actual = append(actual, it.Next().Key())
+			}
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestTrie_Walk(t *testing.T) {
+	trie := NewTrie[int]()
+	for i, key := range []string{"b", "a", "c"} {
+		trie.Set(key, i)
+	}
+
+	var keys []string
+	trie.Walk(func(key string, _ int) bool {
+		keys = append(keys, key)
+		return key != "b"
+	})
+	assert.Equal(t, []string{"a", "b"}, keys)
+}
+
+func TestTrie_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	trie := NewTrie[int]()
+	expected := Dictionary[string, int]{}
+
+	for i := 0; i < 5000; i++ {
+		key := strconv.FormatInt(int64(r.Intn(2000)), 3)
+		if r.Intn(3) == 0 {
+			assert.Equal(t, expected.Has(key), trie.Delete(key))
+			expected.Remove(key)
+		} else {
+			trie.Set(key, i)
+			expected.Set(key, i)
+		}
+	}
+
+	keys := expected.Keys()
+	sort.Strings(keys)
+	assert.Equal(t, keys, trie.Keys())
+	for _, key := range keys {
+		v, err := trie.Get(key)
+		assert.NoError(t, err)
+		assert.Equal(t, expected.GetOrDefault(key, -1), v)
+	}
+}
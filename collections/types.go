@@ -1,5 +1,9 @@
 package collections
 
+import (
+	"golang.org/x/exp/constraints"
+)
+
 type (
 	// Predicate is a function that returns true if the specified element satisfies the predicate.
 	Predicate[T any] func(T) bool
@@ -66,6 +70,35 @@ type (
 		Collection[T]
 	}
 
+	// Map is an interface that represents a collection of key/value pairs with unique keys.
+	Map[K comparable, T any] interface {
+		Iterable[*Entry[K, T]]
+
+		// Get returns the value of the key, or ErrKeyNotFound if the key does not exist.
+		Get(K) (T, error)
+
+		// Set sets the value of the key.
+		Set(K, T)
+
+		// Has returns true if the key exists.
+		Has(K) bool
+
+		// Remove removes the key.
+		Remove(K)
+
+		// Keys returns the keys of this map.
+		Keys() []K
+
+		// Values returns the values of this map.
+		Values() []T
+
+		// Size returns the number of entries in this map.
+		Size() int
+
+		// Entries returns the key/value pairs of this map.
+		Entries() []*Entry[K, T]
+	}
+
 	// Iterator is an interface that represents an iterator over a collection.
 	Iterator[T any] interface {
 
@@ -76,6 +109,11 @@ type (
 		Next() T
 	}
 
+	// Number is a constraint that permits any integer or floating-point type.
+	Number interface {
+		constraints.Integer | constraints.Float
+	}
+
 	collectionInitializer[T any] interface {
 		withElements([]T)
 	}
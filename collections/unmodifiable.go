@@ -0,0 +1,131 @@
+package collections
+
+import "errors"
+
+type (
+	unmodifiableCollection[T any] struct {
+		collection Collection[T]
+	}
+
+	// unmodifiableList is a read-only view of a List.
+	unmodifiableList[T any] struct {
+		unmodifiableCollection[T]
+		list List[T]
+	}
+
+	// unmodifiableSet is a read-only view of a Set.
+	unmodifiableSet[T comparable] struct {
+		unmodifiableCollection[T]
+	}
+)
+
+var (
+	_ List[any]   = (*unmodifiableList[any])(nil)
+	_ Set[string] = (*unmodifiableSet[string])(nil)
+)
+
+var (
+	ErrUnsupportedOperation = errors.New("unsupported operation")
+)
+
+// UnmodifiableList returns a read-only view of the list, the mutators of the view panic with ErrUnsupportedOperation.
+// Changes made to the list are visible through the view.
+func UnmodifiableList[T any](list List[T]) List[T] {
+	return &unmodifiableList[T]{
+		unmodifiableCollection: unmodifiableCollection[T]{collection: list},
+		list:                   list,
+	}
+}
+
+// UnmodifiableSet returns a read-only view of the set, the mutators of the view panic with ErrUnsupportedOperation.
+// Changes made to the set are visible through the view.
+func UnmodifiableSet[T comparable](set Set[T]) Set[T] {
+	return &unmodifiableSet[T]{
+		unmodifiableCollection: unmodifiableCollection[T]{collection: set},
+	}
+}
+
+// NewImmutableList returns a list with a copy of the elements that cannot be modified.
+func NewImmutableList[T any](elements []T) List[T] {
+	return UnmodifiableList[T](NewArrayListWithElements(elements))
+}
+
+// NewImmutableSet returns a set with a copy of the elements that cannot be modified.
+func NewImmutableSet[T comparable](elements []T) Set[T] {
+	return UnmodifiableSet[T](NewValueSetWithElements(elements))
+}
+
+// Add panics with ErrUnsupportedOperation.
+func (u *unmodifiableCollection[T]) Add(T) bool {
+	panic(ErrUnsupportedOperation)
+}
+
+// AddAll panics with ErrUnsupportedOperation.
+func (u *unmodifiableCollection[T]) AddAll([]T) bool {
+	panic(ErrUnsupportedOperation)
+}
+
+// Clear panics with ErrUnsupportedOperation.
+func (u *unmodifiableCollection[T]) Clear() {
+	panic(ErrUnsupportedOperation)
+}
+
+// Remove panics with ErrUnsupportedOperation.
+func (u *unmodifiableCollection[T]) Remove(T) bool {
+	panic(ErrUnsupportedOperation)
+}
+
+// RemoveIf panics with ErrUnsupportedOperation.
+func (u *unmodifiableCollection[T]) RemoveIf(Predicate[T]) bool {
+	panic(ErrUnsupportedOperation)
+}
+
+// Contains returns true if the collection contains the specified element.
+func (u *unmodifiableCollection[T]) Contains(t T) bool {
+	return u.collection.Contains(t)
+}
+
+// IsEmpty returns true if the collection contains no elements.
+func (u *unmodifiableCollection[T]) IsEmpty() bool {
+	return u.collection.IsEmpty()
+}
+
+// Size returns the number of elements in the collection.
+func (u *unmodifiableCollection[T]) Size() int {
+	return u.collection.Size()
+}
+
+// ToArray returns an array containing all the elements in the collection.
+func (u *unmodifiableCollection[T]) ToArray() []T {
+	return u.collection.ToArray()
+}
+
+// Iterator returns an iterator over the elements in the collection.
+func (u *unmodifiableCollection[T]) Iterator() Iterator[T] {
+	return u.collection.Iterator()
+}
+
+// Get returns the element at the specified position in the list.
+func (u *unmodifiableList[T]) Get(i int) T {
+	return u.list.Get(i)
+}
+
+// IndexOf returns the index of the first occurrence of the specified element in the list, or -1 if the list does not contain the element.
+func (u *unmodifiableList[T]) IndexOf(t T) int {
+	return u.list.IndexOf(t)
+}
+
+// AddAt panics with ErrUnsupportedOperation.
+func (u *unmodifiableList[T]) AddAt(int, T) bool {
+	panic(ErrUnsupportedOperation)
+}
+
+// Set panics with ErrUnsupportedOperation.
+func (u *unmodifiableList[T]) Set(int, T) T {
+	panic(ErrUnsupportedOperation)
+}
+
+// RemoveAt panics with ErrUnsupportedOperation.
+func (u *unmodifiableList[T]) RemoveAt(int) T {
+	panic(ErrUnsupportedOperation)
+}
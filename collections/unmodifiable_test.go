@@ -0,0 +1,60 @@
+package collections
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestUnmodifiableList(t *testing.T) {
+	list := NewArrayListWithElements([]int{1, 2, 3})
+	view := UnmodifiableList[int](list)
+
+	assert.Equal(t, 3, view.Size())
+	assert.Equal(t, 2, view.Get(1))
+	assert.True(t, view.Contains(3))
+
+	for name, mutator := range map[string]func(){
+		"Add":      func() { view.Add(4) },
+		"AddAll":   func() { view.AddAll([]int{4}) },
+		"AddAt":    func() { view.AddAt(0, 4) },
+		"Set":      func() { view.Set(0, 4) },
+		"Remove":   func() { view.Remove(1) },
+		"RemoveAt": func() { view.RemoveAt(0) },
+		"RemoveIf": func() { view.RemoveIf(func(int) bool { return true }) },
+		"Clear":    func() { view.Clear() },
+	} {
+		assert.PanicsWithValue(t, ErrUnsupportedOperation, mutator, name)
+	}
+
+	list.Add(4)
+	assert.Equal(t, []int{1, 2, 3, 4}, view.ToArray())
+}
+
+func TestUnmodifiableSet(t *testing.T) {
+	set := NewValueSetWithElements([]int{1, 2, 3})
+	view := UnmodifiableSet[int](set)
+
+	assert.Equal(t, 3, view.Size())
+	assert.True(t, view.Contains(3))
+	assert.PanicsWithValue(t, ErrUnsupportedOperation, func() { view.Add(4) })
+	assert.PanicsWithValue(t, ErrUnsupportedOperation, func() { view.Remove(1) })
+}
+
+func TestNewImmutableList(t *testing.T) {
+	elements := []int{1, 2, 3}
+	list := NewImmutableList(elements)
+	elements[0] = 10
+
+	assert.Equal(t, []int{1, 2, 3}, list.ToArray())
+	assert.PanicsWithValue(t, ErrUnsupportedOperation, func() { list.Add(4) })
+}
+
+func TestNewImmutableSet(t *testing.T) {
+	elements := []int{1, 2, 3}
+	set := NewImmutableSet(elements)
+	elements[0] = 10
+
+	assert.True(t, set.Contains(1))
+	assert.False(t, set.Contains(10))
+	assert.PanicsWithValue(t, ErrUnsupportedOperation, func() { set.Clear() })
+}
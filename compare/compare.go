@@ -0,0 +1,141 @@
+package compare
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+type (
+	// Comparator is a function that returns a negative number when a < b, zero when a == b and a positive number when a > b.
+	Comparator[T any] func(a, b T) int
+)
+
+// Natural returns a Comparator that orders values by their natural order, NaN values are ordered before any other float.
+func Natural[T constraints.Ordered]() Comparator[T] {
+	return func(a, b T) int {
+		aNaN, bNaN := a != a, b != b
+		switch {
+		case aNaN && bNaN:
+			return 0
+		case aNaN || a < b:
+			return -1
+		case bNaN || a > b:
+			return 1
+		}
+		return 0
+	}
+}
+
+// Reverse returns a Comparator that imposes the reverse order of c.
+func Reverse[T any](c Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		return c(b, a)
+	}
+}
+
+// Comparing returns a Comparator that orders values by the natural order of the key extracted with key.
+func Comparing[T any, K constraints.Ordered](key func(T) K) Comparator[T] {
+	return ComparingWith(key, Natural[K]())
+}
+
+// ComparingWith returns a Comparator that orders values by the key extracted with key, using c to compare the keys.
+func ComparingWith[T any, K any](key func(T) K, c Comparator[K]) Comparator[T] {
+	return func(a, b T) int {
+		return c(key(a), key(b))
+	}
+}
+
+// NullsFirst returns a Comparator for pointers that orders nil before any other value, and compares the pointed values with c.
+func NullsFirst[T any](c Comparator[T]) Comparator[*T] {
+	return nulls(c, -1)
+}
+
+// NullsLast returns a Comparator for pointers that orders nil after any other value, and compares the pointed values with c.
+func NullsLast[T any](c Comparator[T]) Comparator[*T] {
+	return nulls(c, 1)
+}
+
+func nulls[T any](c Comparator[T], nilOrder int) Comparator[*T] {
+	return func(a, b *T) int {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return nilOrder
+		case b == nil:
+			return -nilOrder
+		}
+		return c(*a, *b)
+	}
+}
+
+// NaturalString compares two strings treating runs of digits as numbers, so "file2" is ordered before "file10".
+// Numbers that only differ in leading zeros are ordered by the rest of the strings first, and then the first one
+// with fewer leading zeros is ordered first.
+func NaturalString(a, b string) int {
+	i, j, zeros := 0, 0, 0
+	for i < len(a) && j < len(b) {
+		if isDigit(a[i]) && isDigit(b[j]) {
+			ai, bj := i, j
+			for i < len(a) && isDigit(a[i]) {
+				i++
+			}
+			for j < len(b) && isDigit(b[j]) {
+				j++
+			}
+			if r := compareDigits(a[ai:i], b[bj:j]); r != 0 {
+				return r
+			}
+			if zeros == 0 {
+				zeros = (i - ai) - (j - bj)
+			}
+			continue
+		}
+		if a[i] != b[j] {
+			if a[i] < b[j] {
+				return -1
+			}
+			return 1
+		}
+		i++
+		j++
+	}
+	if r := (len(a) - i) - (len(b) - j); r != 0 {
+		return r
+	}
+	return zeros
+}
+
+// ThenComparing returns a Comparator that uses other to order the values that c considers equal.
+func (c Comparator[T]) ThenComparing(other Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		if r := c(a, b); r != 0 {
+			return r
+		}
+		return other(a, b)
+	}
+}
+
+// compareDigits compares two runs of digits by their numeric value, ignoring leading zeros.
+func compareDigits(a, b string) int {
+	ta, tb := trimZeros(a), trimZeros(b)
+	if len(ta) != len(tb) {
+		return len(ta) - len(tb)
+	}
+	for k := 0; k < len(ta); k++ {
+		if ta[k] != tb[k] {
+			return int(ta[k]) - int(tb[k])
+		}
+	}
+	return 0
+}
+
+func trimZeros(s string) string {
+	for len(s) > 1 && s[0] == '0' {
+		s = s[1:]
+	}
+	return s
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,91 @@
+package compare
+
+import (
+	"github.com/ovargas/go-lib/constant"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"sort"
+	"testing"
+)
+
+type person struct {
+	name string
+	age  int
+}
+
+func TestNatural(t *testing.T) {
+	c := Natural[float64]()
+
+	assert.Negative(t, c(1, 2))
+	assert.Positive(t, c(2, 1))
+	assert.Zero(t, c(2, 2))
+	assert.Negative(t, c(math.NaN(), 1))
+	assert.Zero(t, c(math.NaN(), math.NaN()))
+}
+
+func TestReverse(t *testing.T) {
+	c := Reverse(Natural[int]())
+
+	assert.Positive(t, c(1, 2))
+	assert.Negative(t, c(2, 1))
+}
+
+func TestComparing_ThenComparing(t *testing.T) {
+	people := []person{{"bob", 30}, {"alice", 30}, {"carol", 25}}
+	c := Reverse(Comparing(func(p person) int { return p.age })).
+		ThenComparing(Comparing(func(p person) string { return p.name }))
+
+	sort.Slice(people, func(i, j int) bool { return c(people[i], people[j]) < 0 })
+
+	assert.Equal(t, []person{{"alice", 30}, {"bob", 30}, {"carol", 25}}, people)
+}
+
+func TestNullsFirst(t *testing.T) {
+	c := NullsFirst(Natural[int]())
+
+	assert.Negative(t, c(nil, constant.AsPointer(1)))
+	assert.Positive(t, c(constant.AsPointer(1), nil))
+	assert.Zero(t, c(nil, nil))
+	assert.Negative(t, c(constant.AsPointer(1), constant.AsPointer(2)))
+}
+
+func TestNullsLast(t *testing.T) {
+	c := NullsLast(Natural[int]())
+
+	assert.Positive(t, c(nil, constant.AsPointer(1)))
+	assert.Negative(t, c(constant.AsPointer(1), nil))
+}
+
+func TestNaturalString(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"file2", "file10", -1},
+		{"file10", "file2", 1},
+		{"file10", "file10", 0},
+		{"file02", "file2", 1},
+		{"a", "b", -1},
+		{"file", "file1", -1},
+		{"10", "9", 1},
+		{"a01b", "a1c", -1},
+		{"a1c", "a01b", 1},
+		{"a01b", "a1b", 1},
+		{"a1b01", "a01b1", -1},
+		{"a01", "a1b", -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			actual := NaturalString(tt.a, tt.b)
+			switch {
+			case tt.want < 0:
+				assert.Negative(t, actual)
+			case tt.want > 0:
+				assert.Positive(t, actual)
+			default:
+				assert.Zero(t, actual)
+			}
+		})
+	}
+}
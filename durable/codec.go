@@ -0,0 +1,50 @@
+package durable
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+)
+
+type (
+	// Codec encodes the keys and values written to the log and the snapshot.
+	Codec interface {
+		// Marshal returns the encoding of v.
+		Marshal(v any) ([]byte, error)
+
+		// Unmarshal decodes data into the value pointed by v.
+		Unmarshal(data []byte, v any) error
+	}
+
+	jsonCodec struct{}
+
+	gobCodec struct{}
+)
+
+var (
+	// JSONCodec encodes keys and values with encoding/json.
+	JSONCodec Codec = jsonCodec{}
+
+	// GobCodec encodes keys and values with encoding/gob.
+	GobCodec Codec = gobCodec{}
+)
+
+func (jsonCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+func (gobCodec) Marshal(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (gobCodec) Unmarshal(data []byte, v any) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
@@ -0,0 +1,520 @@
+package durable
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ovargas/go-lib/collections"
+)
+
+type (
+
+	// Dictionary is a collections.Dictionary persisted to a directory. Every change is appended to a log before it is
+	// applied, the log is replayed on Open and compacted into a snapshot. It is safe for concurrent use, and the
+	// directory is locked so only one Dictionary at a time can open it.
+	Dictionary[K comparable, V any] struct {
+		mu      sync.RWMutex
+		entries collections.Dictionary[K, V]
+		dir     string
+		lock    *os.File
+		log     *os.File
+		offset  int64
+		failed  error
+		options options
+		records int
+		// compactErr is the error of the last automatic compaction, which is tried again once the log holds another
+		// threshold of changes after failedAt.
+		compactErr error
+		failedAt   int
+		closed     bool
+		stop       chan struct{}
+		done       chan struct{}
+	}
+
+	// SyncPolicy defines when the log is flushed to stable storage with fsync.
+	SyncPolicy int
+
+	// Option configures a Dictionary.
+	Option func(*options)
+
+	options struct {
+		codec               Codec
+		syncPolicy          SyncPolicy
+		syncInterval        time.Duration
+		compactionThreshold int
+		snapshotInterval    time.Duration
+	}
+)
+
+const (
+	// SyncAlways flushes the log after every change, a change is durable when the call that made it returns.
+	SyncAlways SyncPolicy = iota
+
+	// SyncInterval flushes the log periodically, changes made since the last flush can be lost on a system crash.
+	SyncInterval
+
+	// SyncNever leaves the flushing of the log to the operating system.
+	SyncNever
+)
+
+const (
+	lockFile     = "lock"
+	logFile      = "log"
+	snapshotFile = "snapshot"
+)
+
+var (
+	ErrClosed          = errors.New("dictionary is closed")
+	ErrLocked          = errors.New("directory is used by another dictionary")
+	ErrCorruptSnapshot = errors.New("corrupt snapshot")
+	ErrLogFailed       = errors.New("log could not be restored after a failed write")
+)
+
+// WithCodec sets the codec used to encode keys and values, JSONCodec by default.
+func WithCodec(codec Codec) Option {
+	return func(o *options) {
+		o.codec = codec
+	}
+}
+
+// WithSyncPolicy sets when the log is flushed to stable storage, SyncAlways by default. The interval is used by
+// SyncInterval, it is one second if it is not positive.
+func WithSyncPolicy(policy SyncPolicy, interval time.Duration) Option {
+	return func(o *options) {
+		o.syncPolicy = policy
+		if interval > 0 {
+			o.syncInterval = interval
+		}
+	}
+}
+
+// WithCompactionThreshold compacts the log into a snapshot every time it holds the specified number of changes,
+// 0 disables it. It is 10000 by default.
+func WithCompactionThreshold(records int) Option {
+	return func(o *options) {
+		o.compactionThreshold = records
+	}
+}
+
+// WithSnapshotInterval compacts the log into a snapshot periodically, 0 disables it. It is disabled by default.
+func WithSnapshotInterval(interval time.Duration) Option {
+	return func(o *options) {
+		o.snapshotInterval = interval
+	}
+}
+
+// Open opens the dictionary stored in the directory, creating it if it does not exist. The snapshot is loaded and
+// the log replayed, a record left incomplete by a crash at the end of the log is discarded. A damaged record followed
+// by more data is reported as an error and the log is left untouched. It returns ErrLocked if the directory is
+// already open and ErrCorruptSnapshot if the snapshot cannot be read.
+func Open[K comparable, V any](dir string, opts ...Option) (*Dictionary[K, V], error) {
+	d := &Dictionary[K, V]{
+		entries: make(collections.Dictionary[K, V]),
+		dir:     dir,
+		options: options{
+			codec:               JSONCodec,
+			syncPolicy:          SyncAlways,
+			syncInterval:        time.Second,
+			compactionThreshold: 10000,
+		},
+	}
+	for _, opt := range opts {
+		opt(&d.options)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	lock, err := lockDir(filepath.Join(dir, lockFile))
+	if err != nil {
+		return nil, err
+	}
+	d.lock = lock
+	if err := d.load(snapshotFile); err != nil {
+		_ = unlockDir(lock)
+		return nil, err
+	}
+	if err := d.recover(); err != nil {
+		_ = unlockDir(lock)
+		return nil, err
+	}
+
+	if d.options.syncPolicy == SyncInterval || d.options.snapshotInterval > 0 {
+		d.stop = make(chan struct{})
+		d.done = make(chan struct{})
+		go d.background()
+	}
+	return d, nil
+}
+
+// Get returns the value of the key or collections.ErrKeyNotFound if the key does not exist.
+func (d *Dictionary[K, V]) Get(key K) (V, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.entries.Get(key)
+}
+
+// GetOrDefault returns the value of the key or the default value if the key does not exist.
+func (d *Dictionary[K, V]) GetOrDefault(key K, value V) V {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.entries.GetOrDefault(key, value)
+}
+
+// Has returns true if the key exists.
+func (d *Dictionary[K, V]) Has(key K) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.entries.Has(key)
+}
+
+// Keys returns the keys of the dictionary.
+func (d *Dictionary[K, V]) Keys() []K {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.entries.Keys()
+}
+
+// Values returns the values of the dictionary.
+func (d *Dictionary[K, V]) Values() []V {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.entries.Values()
+}
+
+// Size returns the number of entries of the dictionary.
+func (d *Dictionary[K, V]) Size() int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.entries.Size()
+}
+
+// Entries returns the key/value pairs of the dictionary.
+func (d *Dictionary[K, V]) Entries() []*collections.Entry[K, V] {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.entries.Entries()
+}
+
+// Iterator returns an iterator over the entries of the dictionary.
+func (d *Dictionary[K, V]) Iterator() collections.Iterator[*collections.Entry[K, V]] {
+	return collections.IteratorFromSlice(d.Entries())
+}
+
+// ToDictionary returns a copy of the entries in a collections.Dictionary.
+func (d *Dictionary[K, V]) ToDictionary() collections.Dictionary[K, V] {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	result := make(collections.Dictionary[K, V], len(d.entries))
+	result.Merge(d.entries)
+	return result
+}
+
+// Set sets the value of the key, the change is written to the log before it is applied. The change is not applied if
+// the log cannot be written or, with SyncAlways, flushed. A failure of the compaction that may follow is not returned,
+// see CompactionError.
+func (d *Dictionary[K, V]) Set(key K, value V) error {
+	k, err := d.options.codec.Marshal(key)
+	if err != nil {
+		return err
+	}
+	v, err := d.options.codec.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err := d.append(record{op: opSet, key: k, value: v}); err != nil {
+		return err
+	}
+	d.entries.Set(key, value)
+	d.compactIfNeeded()
+	return nil
+}
+
+// Remove removes the key, the change is written to the log before it is applied. The change is not applied if the
+// log cannot be written or, with SyncAlways, flushed. A failure of the compaction that may follow is not returned,
+// see CompactionError.
+func (d *Dictionary[K, V]) Remove(key K) error {
+	k, err := d.options.codec.Marshal(key)
+	if err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.entries.Has(key) {
+		return nil
+	}
+	if err := d.append(record{op: opRemove, key: k}); err != nil {
+		return err
+	}
+	d.entries.Remove(key)
+	d.compactIfNeeded()
+	return nil
+}
+
+// Sync flushes the log to stable storage.
+func (d *Dictionary[K, V]) Sync() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		return ErrClosed
+	}
+	return d.log.Sync()
+}
+
+// CompactionError returns the error of the last automatic compaction, run when the compaction threshold is reached or
+// the snapshot interval elapses, or nil if it succeeded.
+func (d *Dictionary[K, V]) CompactionError() error {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.compactErr
+}
+
+// Snapshot writes the entries to a new snapshot and truncates the log.
+func (d *Dictionary[K, V]) Snapshot() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		return ErrClosed
+	}
+	return d.compact()
+}
+
+// Close flushes and closes the log, the dictionary cannot be used after it is closed.
+func (d *Dictionary[K, V]) Close() error {
+	d.mu.Lock()
+	if d.closed {
+		d.mu.Unlock()
+		return ErrClosed
+	}
+	d.closed = true
+	d.mu.Unlock()
+
+	if d.stop != nil {
+		close(d.stop)
+		<-d.done
+	}
+
+	err := d.log.Sync()
+	if closeErr := d.log.Close(); err == nil {
+		err = closeErr
+	}
+	if unlockErr := unlockDir(d.lock); err == nil {
+		err = unlockErr
+	}
+	return err
+}
+
+func (d *Dictionary[K, V]) background() {
+	defer close(d.done)
+
+	var syncTick, snapshotTick <-chan time.Time
+	if d.options.syncPolicy == SyncInterval {
+		t := time.NewTicker(d.options.syncInterval)
+		defer t.Stop()
+		syncTick = t.C
+	}
+	if d.options.snapshotInterval > 0 {
+		t := time.NewTicker(d.options.snapshotInterval)
+		defer t.Stop()
+		snapshotTick = t.C
+	}
+
+	for {
+		select {
+		case <-d.stop:
+			return
+		case <-syncTick:
+			_ = d.Sync()
+		case <-snapshotTick:
+			d.mu.Lock()
+			if !d.closed && d.records > 0 {
+				d.compactErr = d.compact()
+			}
+			d.mu.Unlock()
+		}
+	}
+}
+
+// append writes the record at the end of the log. If the write or the flush fails the log is truncated back to its
+// previous end, so a torn record never precedes the next ones, and if that fails too the log refuses any other write.
+func (d *Dictionary[K, V]) append(r record) error {
+	if d.closed {
+		return ErrClosed
+	}
+	if d.failed != nil {
+		return d.failed
+	}
+
+	frame := r.encode()
+	_, err := d.log.Write(frame)
+	if err == nil && d.options.syncPolicy == SyncAlways {
+		err = d.log.Sync()
+	}
+	if err != nil {
+		d.rollback()
+		return err
+	}
+	d.offset += int64(len(frame))
+	d.records++
+	return nil
+}
+
+func (d *Dictionary[K, V]) rollback() {
+	err := d.log.Truncate(d.offset)
+	if err == nil {
+		_, err = d.log.Seek(d.offset, io.SeekStart)
+	}
+	if err != nil {
+		d.failed = fmt.Errorf("%w: %v", ErrLogFailed, err)
+	}
+}
+
+// compactIfNeeded compacts the log once it holds the threshold of changes. A failure does not fail the change that
+// triggered it, as that change is already durable, it is kept for CompactionError.
+func (d *Dictionary[K, V]) compactIfNeeded() {
+	threshold := d.options.compactionThreshold
+	if threshold <= 0 || d.records-d.failedAt < threshold {
+		return
+	}
+	if err := d.compact(); err != nil {
+		d.compactErr = err
+		d.failedAt = d.records
+	}
+}
+
+// compact writes the snapshot to a temporary file that replaces the previous one, and then truncates the log. A crash
+// before the log is truncated leaves a log whose replay over the new snapshot produces the same entries.
+func (d *Dictionary[K, V]) compact() error {
+	tmp := filepath.Join(d.dir, snapshotFile+".tmp")
+	if err := d.writeSnapshot(tmp); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	if err := os.Rename(tmp, filepath.Join(d.dir, snapshotFile)); err != nil {
+		return err
+	}
+	if err := syncDir(d.dir); err != nil {
+		return err
+	}
+
+	if err := d.log.Truncate(0); err != nil {
+		return err
+	}
+	if _, err := d.log.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	d.offset = 0
+	d.records = 0
+	d.failedAt = 0
+	d.compactErr = nil
+	return d.log.Sync()
+}
+
+func (d *Dictionary[K, V]) writeSnapshot(path string) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	for key, value := range d.entries {
+		k, err := d.options.codec.Marshal(key)
+		if err != nil {
+			return err
+		}
+		v, err := d.options.codec.Marshal(value)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(record{op: opSet, key: k, value: v}.encode()); err != nil {
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// recover replays the log, truncates a torn record at its end and opens it for appending.
+func (d *Dictionary[K, V]) recover() error {
+	f, err := os.OpenFile(filepath.Join(d.dir, logFile), os.O_CREATE|os.O_RDWR, 0o644)
+	if err != nil {
+		return err
+	}
+	offset, err := readRecords(f, func(r record) error {
+		d.records++
+		return d.apply(r)
+	})
+	if err == nil || errors.Is(err, errTornRecord) {
+		err = f.Truncate(offset)
+	}
+	if err == nil {
+		_, err = f.Seek(offset, io.SeekStart)
+	}
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	d.log = f
+	d.offset = offset
+	return nil
+}
+
+func (d *Dictionary[K, V]) load(name string) error {
+	f, err := os.Open(filepath.Join(d.dir, name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	// The snapshot is replaced atomically, so unlike the log it is never left incomplete by a crash.
+	if _, err := readRecords(f, d.apply); errors.Is(err, errCorruptRecord) || errors.Is(err, errTornRecord) {
+		return ErrCorruptSnapshot
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *Dictionary[K, V]) apply(r record) error {
+	var key K
+	if err := d.options.codec.Unmarshal(r.key, &key); err != nil {
+		return err
+	}
+	if r.op == opRemove {
+		d.entries.Remove(key)
+		return nil
+	}
+	var value V
+	if err := d.options.codec.Unmarshal(r.value, &value); err != nil {
+		return err
+	}
+	d.entries.Set(key, value)
+	return nil
+}
+
+func syncDir(dir string) error {
+	f, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return f.Sync()
+}
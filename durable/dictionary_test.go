@@ -0,0 +1,296 @@
+package durable
+
+import (
+	"github.com/ovargas/go-lib/collections"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestDictionary_Reopen(t *testing.T) {
+	dir := t.TempDir()
+
+	d, err := Open[string, int](dir)
+	assert.NoError(t, err)
+	assert.NoError(t, d.Set("a", 1))
+	assert.NoError(t, d.Set("b", 2))
+	assert.NoError(t, d.Set("a", 3))
+	assert.NoError(t, d.Remove("b"))
+	assert.NoError(t, d.Remove("c"))
+	assert.NoError(t, d.Close())
+
+	d, err = Open[string, int](dir)
+	assert.NoError(t, err)
+	defer d.Close()
+
+	assert.Equal(t, collections.Dictionary[string, int]{"a": 3}, d.ToDictionary())
+	v, err := d.Get("a")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, v)
+	_, err = d.Get("b")
+	assert.ErrorIs(t, err, collections.ErrKeyNotFound)
+}
+
+func TestDictionary_RecoverTruncatedLog(t *testing.T) {
+	dir := t.TempDir()
+
+	d, err := Open[string, string](dir)
+	assert.NoError(t, err)
+	assert.NoError(t, d.Set("a", "1"))
+	assert.NoError(t, d.Set("b", "2"))
+	assert.NoError(t, d.Close())
+
+	// Simulate a crash in the middle of a write by cutting the last record.
+	path := filepath.Join(dir, logFile)
+	info, err := os.Stat(path)
+	assert.NoError(t, err)
+	assert.NoError(t, os.Truncate(path, info.Size()-3))
+
+	d, err = Open[string, string](dir)
+	assert.NoError(t, err)
+	assert.Equal(t, collections.Dictionary[string, string]{"a": "1"}, d.ToDictionary())
+
+	// New records are appended after the last valid one.
+	assert.NoError(t, d.Set("c", "3"))
+	assert.NoError(t, d.Close())
+
+	d, err = Open[string, string](dir)
+	assert.NoError(t, err)
+	defer d.Close()
+	assert.Equal(t, collections.Dictionary[string, string]{"a": "1", "c": "3"}, d.ToDictionary())
+}
+
+func TestDictionary_RecoverCorruptLog(t *testing.T) {
+	dir := t.TempDir()
+
+	d, err := Open[string, int](dir)
+	assert.NoError(t, err)
+	assert.NoError(t, d.Set("a", 1))
+	assert.NoError(t, d.Close())
+
+	f, err := os.OpenFile(filepath.Join(dir, logFile), os.O_APPEND|os.O_WRONLY, 0o644)
+	assert.NoError(t, err)
+	_, err = f.Write([]byte{0xff, 0xff, 0xff, 0x7f, 1, 2, 3, 4, 5})
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+
+	d, err = Open[string, int](dir)
+	assert.NoError(t, err)
+	defer d.Close()
+	assert.Equal(t, collections.Dictionary[string, int]{"a": 1}, d.ToDictionary())
+}
+
+func TestDictionary_Snapshot(t *testing.T) {
+	dir := t.TempDir()
+
+	d, err := Open[int, string](dir, WithCodec(GobCodec), WithSyncPolicy(SyncNever, 0))
+	assert.NoError(t, err)
+	assert.NoError(t, d.Set(1, "a"))
+	assert.NoError(t, d.Set(2, "b"))
+	assert.NoError(t, d.Snapshot())
+	assert.NoError(t, d.Remove(1))
+	assert.NoError(t, d.Close())
+
+	info, err := os.Stat(filepath.Join(dir, logFile))
+	assert.NoError(t, err)
+	assert.NotZero(t, info.Size())
+
+	d, err = Open[int, string](dir, WithCodec(GobCodec))
+	assert.NoError(t, err)
+	defer d.Close()
+	assert.Equal(t, collections.Dictionary[int, string]{2: "b"}, d.ToDictionary())
+}
+
+func TestDictionary_CompactionThreshold(t *testing.T) {
+	dir := t.TempDir()
+
+	d, err := Open[string, int](dir, WithCompactionThreshold(10))
+	assert.NoError(t, err)
+	for i := 0; i < 25; i++ {
+		assert.NoError(t, d.Set("counter", i))
+	}
+	assert.Equal(t, 5, d.records)
+	assert.NoError(t, d.Close())
+
+	d, err = Open[string, int](dir)
+	assert.NoError(t, err)
+	defer d.Close()
+	assert.Equal(t, 24, d.GetOrDefault("counter", -1))
+	assert.Equal(t, 1, d.Size())
+}
+
+func TestDictionary_Background(t *testing.T) {
+	dir := t.TempDir()
+
+	d, err := Open[string, int](dir, WithSyncPolicy(SyncInterval, time.Millisecond), WithSnapshotInterval(time.Millisecond))
+	assert.NoError(t, err)
+	assert.NoError(t, d.Set("a", 1))
+
+	assert.Eventually(t, func() bool {
+		info, err := os.Stat(filepath.Join(dir, snapshotFile))
+		return err == nil && info.Size() > 0
+	}, time.Second, time.Millisecond)
+	assert.NoError(t, d.Close())
+
+	d, err = Open[string, int](dir)
+	assert.NoError(t, err)
+	defer d.Close()
+	assert.True(t, d.Has("a"))
+}
+
+func TestDictionary_Closed(t *testing.T) {
+	d, err := Open[string, int](t.TempDir())
+	assert.NoError(t, err)
+	assert.NoError(t, d.Close())
+
+	assert.ErrorIs(t, d.Set("a", 1), ErrClosed)
+	assert.ErrorIs(t, d.Sync(), ErrClosed)
+	assert.ErrorIs(t, d.Snapshot(), ErrClosed)
+	assert.ErrorIs(t, d.Close(), ErrClosed)
+}
+
+func TestDictionary_Locked(t *testing.T) {
+	dir := t.TempDir()
+
+	d, err := Open[string, int](dir)
+	assert.NoError(t, err)
+	_, err = Open[string, int](dir)
+	assert.ErrorIs(t, err, ErrLocked)
+	assert.NoError(t, d.Close())
+
+	d, err = Open[string, int](dir)
+	assert.NoError(t, err)
+	assert.NoError(t, d.Close())
+}
+
+func TestDictionary_CorruptSnapshot(t *testing.T) {
+	dir := t.TempDir()
+
+	d, err := Open[string, int](dir)
+	assert.NoError(t, err)
+	assert.NoError(t, d.Set("a", 1))
+	assert.NoError(t, d.Set("b", 2))
+	assert.NoError(t, d.Snapshot())
+	assert.NoError(t, d.Close())
+
+	path := filepath.Join(dir, snapshotFile)
+	info, err := os.Stat(path)
+	assert.NoError(t, err)
+	assert.NoError(t, os.Truncate(path, info.Size()-3))
+
+	_, err = Open[string, int](dir)
+	assert.ErrorIs(t, err, ErrCorruptSnapshot)
+
+	// A failed Open releases the directory.
+	assert.NoError(t, os.Remove(path))
+	d, err = Open[string, int](dir)
+	assert.NoError(t, err)
+	assert.NoError(t, d.Close())
+}
+
+func TestDictionary_FailedWrite(t *testing.T) {
+	d, err := Open[string, int](t.TempDir())
+	assert.NoError(t, err)
+	assert.NoError(t, d.Set("a", 1))
+
+	// A log that can be neither written nor truncated refuses every later change.
+	assert.NoError(t, d.log.Close())
+	assert.Error(t, d.Set("b", 2))
+	assert.ErrorIs(t, d.Set("c", 3), ErrLogFailed)
+	assert.ErrorIs(t, d.Remove("a"), ErrLogFailed)
+	assert.Equal(t, collections.Dictionary[string, int]{"a": 1}, d.ToDictionary())
+	assert.Error(t, d.Close())
+}
+
+func TestDictionary_SyncIntervalDefault(t *testing.T) {
+	d, err := Open[string, int](t.TempDir(), WithSyncPolicy(SyncInterval, 0))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Second, d.options.syncInterval)
+	assert.NoError(t, d.Set("a", 1))
+	assert.NoError(t, d.Close())
+}
+
+func TestDictionary_CorruptLogBeforeEnd(t *testing.T) {
+	write := func(t *testing.T) string {
+		dir := t.TempDir()
+		d, err := Open[string, int](dir)
+		assert.NoError(t, err)
+		for i, k := range []string{"a", "b", "c", "d"} {
+			assert.NoError(t, d.Set(k, i))
+		}
+		assert.NoError(t, d.Close())
+		return dir
+	}
+	corrupt := func(t *testing.T, dir string, at int64, value byte) int64 {
+		f, err := os.OpenFile(filepath.Join(dir, logFile), os.O_RDWR, 0o644)
+		assert.NoError(t, err)
+		defer f.Close()
+		_, err = f.WriteAt([]byte{value}, at)
+		assert.NoError(t, err)
+		info, err := f.Stat()
+		assert.NoError(t, err)
+		return info.Size()
+	}
+
+	tests := []struct {
+		name  string
+		at    int64
+		value byte
+	}{
+		{"Payload", headerSize + 3, 'x'},
+		{"Checksum", 5, 0},
+		{"LongLength", 2, 0x7f},
+		{"ShortLength", 0, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := write(t)
+			size := corrupt(t, dir, tt.at, tt.value)
+
+			_, err := Open[string, int](dir)
+			assert.ErrorIs(t, err, errCorruptRecord)
+			info, err := os.Stat(filepath.Join(dir, logFile))
+			assert.NoError(t, err)
+			assert.Equal(t, size, info.Size())
+		})
+	}
+
+	// A damaged last record is what a crash leaves, it is discarded.
+	dir := write(t)
+	info, err := os.Stat(filepath.Join(dir, logFile))
+	assert.NoError(t, err)
+	corrupt(t, dir, info.Size()-1, 'x')
+	d, err := Open[string, int](dir)
+	assert.NoError(t, err)
+	defer d.Close()
+	assert.Equal(t, collections.Dictionary[string, int]{"a": 0, "b": 1, "c": 2}, d.ToDictionary())
+}
+
+func TestDictionary_CompactionFailure(t *testing.T) {
+	dir := t.TempDir()
+	tmp := filepath.Join(dir, snapshotFile+".tmp")
+	assert.NoError(t, os.MkdirAll(filepath.Join(tmp, "file"), 0o755))
+
+	d, err := Open[string, int](dir, WithCompactionThreshold(2))
+	assert.NoError(t, err)
+	assert.NoError(t, d.Set("a", 1))
+	assert.NoError(t, d.Set("b", 2))
+	assert.Error(t, d.CompactionError())
+	assert.Equal(t, 1, d.GetOrDefault("a", 0))
+
+	// The compaction is tried again after another threshold of changes.
+	assert.NoError(t, os.RemoveAll(tmp))
+	assert.NoError(t, d.Remove("a"))
+	assert.Equal(t, 3, d.records)
+	assert.NoError(t, d.Set("c", 3))
+	assert.NoError(t, d.CompactionError())
+	assert.Equal(t, 0, d.records)
+	assert.NoError(t, d.Close())
+
+	d, err = Open[string, int](dir)
+	assert.NoError(t, err)
+	defer d.Close()
+	assert.Equal(t, collections.Dictionary[string, int]{"b": 2, "c": 3}, d.ToDictionary())
+}
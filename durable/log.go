@@ -0,0 +1,131 @@
+package durable
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+)
+
+type (
+	operation byte
+
+	// record is a single change written to the log, or an entry written to the snapshot.
+	record struct {
+		op    operation
+		key   []byte
+		value []byte
+	}
+)
+
+const (
+	opSet operation = iota + 1
+	opRemove
+)
+
+// headerSize is the size of the frame header: the payload length and its CRC-32 checksum.
+const headerSize = 8
+
+var (
+	errCorruptRecord = errors.New("corrupt record")
+	errTornRecord    = errors.New("incomplete record")
+)
+
+// encode returns the record framed as length, checksum and payload, where the payload is the operation, the key
+// length as an uvarint, the key and the value.
+func (r record) encode() []byte {
+	payload := make([]byte, 0, 1+binary.MaxVarintLen64+len(r.key)+len(r.value))
+	payload = append(payload, byte(r.op))
+	payload = binary.AppendUvarint(payload, uint64(len(r.key)))
+	payload = append(payload, r.key...)
+	payload = append(payload, r.value...)
+
+	frame := make([]byte, headerSize, headerSize+len(payload))
+	binary.LittleEndian.PutUint32(frame[0:4], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(frame[4:8], crc32.ChecksumIEEE(payload))
+	return append(frame, payload...)
+}
+
+func decodeRecord(payload []byte) (record, error) {
+	if len(payload) < 2 {
+		return record{}, errCorruptRecord
+	}
+	op := operation(payload[0])
+	if op != opSet && op != opRemove {
+		return record{}, errCorruptRecord
+	}
+	n, size := binary.Uvarint(payload[1:])
+	if size <= 0 || uint64(len(payload)-1-size) < n {
+		return record{}, errCorruptRecord
+	}
+	keyStart := 1 + size
+	keyEnd := keyStart + int(n)
+	return record{op: op, key: payload[keyStart:keyEnd], value: payload[keyEnd:]}, nil
+}
+
+// readRecords calls fn for every valid record of r, it returns the offset after the last valid record. A damaged
+// frame at the end of r, as left by a crash in the middle of a write, ends the reading with errTornRecord. A damaged
+// frame followed by more data ends it with errCorruptRecord.
+func readRecords(r io.Reader, fn func(record) error) (int64, error) {
+	reader := bufio.NewReader(r)
+	var offset int64
+	header := make([]byte, headerSize)
+	for {
+		switch _, err := io.ReadFull(reader, header); err {
+		case nil:
+		case io.EOF:
+			return offset, nil
+		case io.ErrUnexpectedEOF:
+			return offset, errTornRecord
+		default:
+			return offset, err
+		}
+		length := binary.LittleEndian.Uint32(header[0:4])
+		checksum := binary.LittleEndian.Uint32(header[4:8])
+
+		// The payload is copied rather than allocated upfront, so a corrupt length cannot exhaust the memory.
+		var buf bytes.Buffer
+		if _, err := io.CopyN(&buf, reader, int64(length)); err == io.EOF {
+			// A frame running past the end is torn, unless its length is corrupt and hides valid frames.
+			if containsFrame(append(header[1:], buf.Bytes()...)) {
+				return offset, fmt.Errorf("%w at offset %d", errCorruptRecord, offset)
+			}
+			return offset, errTornRecord
+		} else if err != nil {
+			return offset, err
+		}
+		payload := buf.Bytes()
+		rec, err := decodeRecord(payload)
+		if err != nil || crc32.ChecksumIEEE(payload) != checksum {
+			if _, err := reader.Peek(1); err == io.EOF {
+				return offset, errTornRecord
+			}
+			return offset, fmt.Errorf("%w at offset %d", errCorruptRecord, offset)
+		}
+		if err := fn(rec); err != nil {
+			return offset, err
+		}
+		offset += headerSize + int64(length)
+	}
+}
+
+// containsFrame returns true if a valid frame starts anywhere in data.
+func containsFrame(data []byte) bool {
+	for i := 0; i+headerSize <= len(data); i++ {
+		length := binary.LittleEndian.Uint32(data[i : i+4])
+		if uint64(length) > uint64(len(data)-i-headerSize) {
+			continue
+		}
+		payload := data[i+headerSize : i+headerSize+int(length)]
+		if crc32.ChecksumIEEE(payload) != binary.LittleEndian.Uint32(data[i+4:i+8]) {
+			continue
+		}
+		if _, err := decodeRecord(payload); err == nil {
+			return true
+		}
+	}
+	return false
+}
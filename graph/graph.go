@@ -0,0 +1,238 @@
+package graph
+
+import (
+	"errors"
+
+	"github.com/ovargas/go-lib/collections"
+	"golang.org/x/exp/constraints"
+)
+
+type (
+
+	// Weight is the type of the weights of the edges.
+	Weight interface {
+		constraints.Integer | constraints.Float
+	}
+
+	// Graph is a directed or undirected graph of nodes keyed by a comparable type and connected by weighted edges.
+	// The nodes and edges are returned in no particular order.
+	Graph[N comparable, W Weight] struct {
+		directed bool
+		// successors maps every node to its adjacent nodes and the weight of the edge to them.
+		successors collections.Dictionary[N, collections.Dictionary[N, W]]
+		// predecessors maps every node to the nodes with an edge to it, it is only kept for directed graphs.
+		predecessors collections.Dictionary[N, *collections.ValueSet[N]]
+		edges        int
+	}
+
+	// Edge is a weighted connection between two nodes.
+	Edge[N comparable, W Weight] struct {
+		from   N
+		to     N
+		weight W
+	}
+)
+
+var (
+	ErrNodeNotFound    = errors.New("node not found")
+	ErrEdgeNotFound    = errors.New("edge not found")
+	ErrDirectedGraph   = errors.New("operation requires an undirected graph")
+	ErrUndirectedGraph = errors.New("operation requires a directed graph")
+	ErrNegativeWeight  = errors.New("negative edge weight")
+	ErrNoPath          = errors.New("no path between the nodes")
+	ErrCycle           = errors.New("graph contains a cycle")
+)
+
+// NewDirected returns a new empty directed graph.
+func NewDirected[N comparable, W Weight]() *Graph[N, W] {
+	return &Graph[N, W]{
+		directed:     true,
+		successors:   collections.Dictionary[N, collections.Dictionary[N, W]]{},
+		predecessors: collections.Dictionary[N, *collections.ValueSet[N]]{},
+	}
+}
+
+// NewUndirected returns a new empty undirected graph.
+func NewUndirected[N comparable, W Weight]() *Graph[N, W] {
+	return &Graph[N, W]{
+		successors: collections.Dictionary[N, collections.Dictionary[N, W]]{},
+	}
+}
+
+// From returns the node the edge starts from.
+func (e *Edge[N, W]) From() N {
+	return e.from
+}
+
+// To returns the node the edge ends at.
+func (e *Edge[N, W]) To() N {
+	return e.to
+}
+
+// Weight returns the weight of the edge.
+func (e *Edge[N, W]) Weight() W {
+	return e.weight
+}
+
+// IsDirected returns true if the edges of the graph have a direction.
+func (g *Graph[N, W]) IsDirected() bool {
+	return g.directed
+}
+
+// AddNode adds the node to the graph, it returns false if the node already exists.
+func (g *Graph[N, W]) AddNode(n N) bool {
+	if g.successors.Has(n) {
+		return false
+	}
+	g.successors.Set(n, collections.Dictionary[N, W]{})
+	if g.directed {
+		g.predecessors.Set(n, collections.NewValueSet[N]())
+	}
+	return true
+}
+
+// RemoveNode removes the node and its edges from the graph, it returns false if the node does not exist.
+func (g *Graph[N, W]) RemoveNode(n N) bool {
+	successors, ok := g.successors[n]
+	if !ok {
+		return false
+	}
+
+	for _, to := range successors.Keys() {
+		g.RemoveEdge(n, to)
+	}
+	if g.directed {
+		for _, from := range g.predecessors[n].ToArray() {
+			g.RemoveEdge(from, n)
+		}
+		g.predecessors.Remove(n)
+	}
+	g.successors.Remove(n)
+	return true
+}
+
+// HasNode returns true if the node exists.
+func (g *Graph[N, W]) HasNode(n N) bool {
+	return g.successors.Has(n)
+}
+
+// Nodes returns the nodes of the graph.
+func (g *Graph[N, W]) Nodes() []N {
+	return g.successors.Keys()
+}
+
+// Order returns the number of nodes in the graph.
+func (g *Graph[N, W]) Order() int {
+	return g.successors.Size()
+}
+
+// Size returns the number of edges in the graph.
+func (g *Graph[N, W]) Size() int {
+	return g.edges
+}
+
+// AddEdge adds an edge with the weight between the nodes, adding the nodes that do not exist. It returns false if the
+// edge already existed, in which case its weight is replaced.
+func (g *Graph[N, W]) AddEdge(from, to N, weight W) bool {
+	g.AddNode(from)
+	g.AddNode(to)
+
+	added := !g.successors[from].Has(to)
+	g.successors[from].Set(to, weight)
+	if g.directed {
+		g.predecessors[to].Add(from)
+	} else {
+		g.successors[to].Set(from, weight)
+	}
+	if added {
+		g.edges++
+	}
+	return added
+}
+
+// RemoveEdge removes the edge between the nodes, it returns false if the edge does not exist.
+func (g *Graph[N, W]) RemoveEdge(from, to N) bool {
+	if !g.HasEdge(from, to) {
+		return false
+	}
+
+	g.successors[from].Remove(to)
+	if g.directed {
+		g.predecessors[to].Remove(from)
+	} else {
+		g.successors[to].Remove(from)
+	}
+	g.edges--
+	return true
+}
+
+// HasEdge returns true if there is an edge from a node to the other, the order of the nodes only matters for directed
+// graphs.
+func (g *Graph[N, W]) HasEdge(from, to N) bool {
+	successors, ok := g.successors[from]
+	return ok && successors.Has(to)
+}
+
+// Weight returns the weight of the edge between the nodes, or ErrEdgeNotFound if the edge does not exist.
+func (g *Graph[N, W]) Weight(from, to N) (W, error) {
+	if !g.HasEdge(from, to) {
+		var zero W
+		return zero, ErrEdgeNotFound
+	}
+	return g.successors[from][to], nil
+}
+
+// Edges returns the edges of the graph, every edge of an undirected graph is returned once.
+func (g *Graph[N, W]) Edges() []*Edge[N, W] {
+	edges := make([]*Edge[N, W], 0, g.edges)
+	seen := collections.NewValueSet[N]()
+	for from, successors := range g.successors {
+		for to, weight := range successors {
+			if !g.directed && seen.Contains(to) {
+				continue
+			}
+			edges = append(edges, &Edge[N, W]{from: from, to: to, weight: weight})
+		}
+		seen.Add(from)
+	}
+	return edges
+}
+
+// Successors returns the nodes the node has an edge to, or ErrNodeNotFound if the node does not exist. The successors
+// of a node of an undirected graph are its neighbors.
+func (g *Graph[N, W]) Successors(n N) ([]N, error) {
+	successors, ok := g.successors[n]
+	if !ok {
+		return nil, ErrNodeNotFound
+	}
+	return successors.Keys(), nil
+}
+
+// Predecessors returns the nodes with an edge to the node, or ErrNodeNotFound if the node does not exist. The
+// predecessors of a node of an undirected graph are its neighbors.
+func (g *Graph[N, W]) Predecessors(n N) ([]N, error) {
+	if !g.directed {
+		return g.Successors(n)
+	}
+	predecessors, ok := g.predecessors[n]
+	if !ok {
+		return nil, ErrNodeNotFound
+	}
+	return predecessors.ToArray(), nil
+}
+
+// OutDegree returns the number of edges starting from the node.
+func (g *Graph[N, W]) OutDegree(n N) int {
+	return g.successors[n].Size()
+}
+
+// InDegree returns the number of edges ending at the node.
+func (g *Graph[N, W]) InDegree(n N) int {
+	if !g.directed {
+		return g.OutDegree(n)
+	}
+	if predecessors, ok := g.predecessors[n]; ok {
+		return predecessors.Size()
+	}
+	return 0
+}
@@ -0,0 +1,91 @@
+package graph
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGraph_AddEdge(t *testing.T) {
+	tests := []struct {
+		name                 string
+		graph                *Graph[string, int]
+		expectedReverse      bool
+		expectedPredecessors []string
+	}{
+		{
+			name:                 "Directed",
+			graph:                NewDirected[string, int](),
+			expectedReverse:      false,
+			expectedPredecessors: []string{"a"},
+		},
+		{
+			name:                 "Undirected",
+			graph:                NewUndirected[string, int](),
+			expectedReverse:      true,
+			expectedPredecessors: []string{"a", "c"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := tt.graph
+			assert.True(t, g.AddEdge("a", "b", 1))
+			assert.True(t, g.AddEdge("b", "c", 2))
+			assert.False(t, g.AddEdge("a", "b", 3))
+
+			assert.Equal(t, 3, g.Order())
+			assert.Equal(t, 2, g.Size())
+			assert.Len(t, g.Edges(), 2)
+			assert.True(t, g.HasEdge("a", "b"))
+			assert.Equal(t, tt.expectedReverse, g.HasEdge("b", "a"))
+
+			w, err := g.Weight("a", "b")
+			assert.NoError(t, err)
+			assert.Equal(t, 3, w)
+
+			_, err = g.Weight("a", "c")
+			assert.ErrorIs(t, err, ErrEdgeNotFound)
+
+			predecessors, err := g.Predecessors("b")
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, tt.expectedPredecessors, predecessors)
+			assert.Equal(t, len(tt.expectedPredecessors), g.InDegree("b"))
+
+			_, err = g.Successors("z")
+			assert.ErrorIs(t, err, ErrNodeNotFound)
+		})
+	}
+}
+
+func TestGraph_RemoveNode(t *testing.T) {
+	tests := []struct {
+		name  string
+		graph *Graph[string, int]
+	}{
+		{name: "Directed", graph: NewDirected[string, int]()},
+		{name: "Undirected", graph: NewUndirected[string, int]()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := tt.graph
+			g.AddEdge("a", "b", 1)
+			g.AddEdge("b", "c", 1)
+			g.AddEdge("c", "a", 1)
+			g.AddEdge("b", "b", 1)
+			g.AddNode("d")
+
+			assert.True(t, g.RemoveNode("b"))
+			assert.False(t, g.RemoveNode("b"))
+			assert.False(t, g.HasNode("b"))
+			assert.ElementsMatch(t, []string{"a", "c", "d"}, g.Nodes())
+			assert.Equal(t, 1, g.Size())
+			assert.True(t, g.HasEdge("c", "a"))
+
+			assert.True(t, g.RemoveEdge("c", "a"))
+			assert.False(t, g.RemoveEdge("c", "a"))
+			assert.Equal(t, 0, g.Size())
+			assert.Empty(t, g.Edges())
+		})
+	}
+}
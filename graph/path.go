@@ -0,0 +1,109 @@
+package graph
+
+import (
+	"container/heap"
+
+	"github.com/ovargas/go-lib/collections"
+)
+
+type (
+
+	// Heuristic estimates the weight of the path from a node to the target. It must never overestimate it, and the
+	// estimate must not drop by more than the weight of the edge between two nodes.
+	Heuristic[N comparable, W Weight] func(N) W
+
+	pathItem[N comparable, W Weight] struct {
+		node     N
+		priority W
+	}
+
+	pathQueue[N comparable, W Weight] []pathItem[N, W]
+)
+
+// ShortestPath returns the path with the least total weight between the nodes using Dijkstra's algorithm, along with
+// its weight. It returns ErrNodeNotFound if a node does not exist, ErrNoPath if the target is not reachable and
+// ErrNegativeWeight if it finds an edge with a negative weight.
+func (g *Graph[N, W]) ShortestPath(from, to N) ([]N, W, error) {
+	return g.AStar(from, to, nil)
+}
+
+// AStar returns the path with the least total weight between the nodes using the A* algorithm guided by the
+// heuristic, along with its weight. A nil heuristic makes it equivalent to ShortestPath. It returns the same errors
+// as ShortestPath.
+func (g *Graph[N, W]) AStar(from, to N, heuristic Heuristic[N, W]) ([]N, W, error) {
+	var zero W
+	if !g.HasNode(from) || !g.HasNode(to) {
+		return nil, zero, ErrNodeNotFound
+	}
+	if heuristic == nil {
+		heuristic = func(N) W { return zero }
+	}
+
+	distances := collections.Dictionary[N, W]{from: zero}
+	previous := collections.Dictionary[N, N]{}
+	closed := collections.NewValueSet[N]()
+	queue := &pathQueue[N, W]{{node: from, priority: heuristic(from)}}
+
+	for queue.Len() > 0 {
+		n := heap.Pop(queue).(pathItem[N, W]).node
+		if n == to {
+			return pathTo(previous, from, to), distances[to], nil
+		}
+		if closed.Contains(n) {
+			continue
+		}
+		closed.Add(n)
+
+		for next, weight := range g.successors[n] {
+			if weight < zero {
+				return nil, zero, ErrNegativeWeight
+			}
+			if closed.Contains(next) {
+				continue
+			}
+			distance := distances[n] + weight
+			if current, ok := distances[next]; ok && current <= distance {
+				continue
+			}
+			distances.Set(next, distance)
+			previous.Set(next, n)
+			heap.Push(queue, pathItem[N, W]{node: next, priority: distance + heuristic(next)})
+		}
+	}
+	return nil, zero, ErrNoPath
+}
+
+func pathTo[N comparable](previous collections.Dictionary[N, N], from, to N) []N {
+	path := []N{to}
+	for n := to; n != from; {
+		n = previous[n]
+		path = append(path, n)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+func (q pathQueue[N, W]) Len() int {
+	return len(q)
+}
+
+func (q pathQueue[N, W]) Less(i, j int) bool {
+	return q[i].priority < q[j].priority
+}
+
+func (q pathQueue[N, W]) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+}
+
+func (q *pathQueue[N, W]) Push(x any) {
+	*q = append(*q, x.(pathItem[N, W]))
+}
+
+func (q *pathQueue[N, W]) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
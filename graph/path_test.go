@@ -0,0 +1,96 @@
+package graph
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type cell struct {
+	x, y int
+}
+
+func TestGraph_ShortestPath(t *testing.T) {
+	g := NewDirected[string, float64]()
+	g.AddEdge("a", "b", 7)
+	g.AddEdge("a", "c", 9)
+	g.AddEdge("a", "f", 14)
+	g.AddEdge("b", "c", 10)
+	g.AddEdge("b", "d", 15)
+	g.AddEdge("c", "d", 11)
+	g.AddEdge("c", "f", 2)
+	g.AddEdge("d", "e", 6)
+	g.AddEdge("f", "e", 9)
+	g.AddNode("g")
+
+	tests := []struct {
+		name           string
+		from, to       string
+		expectedPath   []string
+		expectedWeight float64
+		expectedErr    error
+	}{
+		{name: "Path", from: "a", to: "e", expectedPath: []string{"a", "c", "f", "e"}, expectedWeight: 20},
+		{name: "Same node", from: "a", to: "a", expectedPath: []string{"a"}, expectedWeight: 0},
+		{name: "Unreachable", from: "e", to: "a", expectedErr: ErrNoPath},
+		{name: "Isolated", from: "a", to: "g", expectedErr: ErrNoPath},
+		{name: "Missing node", from: "a", to: "z", expectedErr: ErrNodeNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, weight, err := g.ShortestPath(tt.from, tt.to)
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expectedPath, path)
+			assert.Equal(t, tt.expectedWeight, weight)
+		})
+	}
+}
+
+func TestGraph_ShortestPath_NegativeWeight(t *testing.T) {
+	g := NewDirected[string, int]()
+	g.AddEdge("a", "b", -1)
+	g.AddEdge("b", "c", 1)
+
+	_, _, err := g.ShortestPath("a", "c")
+	assert.ErrorIs(t, err, ErrNegativeWeight)
+}
+
+func TestGraph_AStar(t *testing.T) {
+	g := NewUndirected[cell, int]()
+	walls := map[cell]bool{{2, 0}: true, {2, 1}: true, {2, 2}: true, {2, 3}: true}
+	for x := 0; x < 5; x++ {
+		for y := 0; y < 5; y++ {
+			if walls[cell{x, y}] {
+				continue
+			}
+			if x < 4 && !walls[cell{x + 1, y}] {
+				g.AddEdge(cell{x, y}, cell{x + 1, y}, 1)
+			}
+			if y < 4 && !walls[cell{x, y + 1}] {
+				g.AddEdge(cell{x, y}, cell{x, y + 1}, 1)
+			}
+		}
+	}
+
+	target := cell{4, 0}
+	manhattan := func(c cell) int {
+		dx, dy := target.x-c.x, target.y-c.y
+		if dx < 0 {
+			dx = -dx
+		}
+		if dy < 0 {
+			dy = -dy
+		}
+		return dx + dy
+	}
+
+	path, weight, err := g.AStar(cell{0, 0}, target, manhattan)
+	assert.NoError(t, err)
+	assert.Equal(t, 12, weight)
+	assert.Len(t, path, 13)
+	assert.Contains(t, path, cell{2, 4})
+
+	_, expected, err := g.ShortestPath(cell{0, 0}, target)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, weight)
+}
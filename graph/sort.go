@@ -0,0 +1,158 @@
+package graph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ovargas/go-lib/collections"
+)
+
+type (
+
+	// CycleError is returned by TopologicalSort when the graph is not acyclic, it wraps ErrCycle.
+	CycleError[N comparable] struct {
+		cycle []N
+	}
+)
+
+// Cycle returns the nodes of the cycle in the direction of its edges, the first node is repeated at the end.
+func (e *CycleError[N]) Cycle() []N {
+	return e.cycle
+}
+
+// Error returns the description of the cycle.
+func (e *CycleError[N]) Error() string {
+	nodes := make([]string, len(e.cycle))
+	for i, n := range e.cycle {
+		nodes[i] = fmt.Sprint(n)
+	}
+	return fmt.Sprintf("%s: %s", ErrCycle, strings.Join(nodes, " -> "))
+}
+
+// Unwrap returns ErrCycle.
+func (e *CycleError[N]) Unwrap() error {
+	return ErrCycle
+}
+
+// TopologicalSort returns the nodes of a directed graph ordered so that every edge goes from a node to a later one.
+// It returns a *CycleError with one of the cycles if the graph is not acyclic, and ErrUndirectedGraph if the graph is
+// undirected.
+func (g *Graph[N, W]) TopologicalSort() ([]N, error) {
+	if !g.directed {
+		return nil, ErrUndirectedGraph
+	}
+
+	inDegrees := make(collections.Dictionary[N, int], g.Order())
+	var ready []N
+	for n, predecessors := range g.predecessors {
+		inDegrees.Set(n, predecessors.Size())
+		if predecessors.IsEmpty() {
+			ready = append(ready, n)
+		}
+	}
+
+	order := make([]N, 0, g.Order())
+	for len(ready) > 0 {
+		n := ready[0]
+		ready = ready[1:]
+		order = append(order, n)
+		inDegrees.Remove(n)
+		for to := range g.successors[n] {
+			inDegrees[to]--
+			if inDegrees[to] == 0 {
+				ready = append(ready, to)
+			}
+		}
+	}
+
+	if len(order) < g.Order() {
+		return nil, &CycleError[N]{cycle: g.findCycle(inDegrees)}
+	}
+	return order, nil
+}
+
+// findCycle returns a cycle among the nodes left by the topological sort, every one of them has a predecessor that
+// was also left, so walking the predecessors backwards eventually repeats a node.
+func (g *Graph[N, W]) findCycle(left collections.Dictionary[N, int]) []N {
+	var path []N
+	positions := collections.Dictionary[N, int]{}
+	n := left.Keys()[0]
+	for !positions.Has(n) {
+		positions.Set(n, len(path))
+		path = append(path, n)
+		for _, from := range g.predecessors[n].ToArray() {
+			if left.Has(from) {
+				n = from
+				break
+			}
+		}
+	}
+
+	backwards := path[positions[n]:]
+	cycle := make([]N, 0, len(backwards)+1)
+	cycle = append(cycle, n)
+	for i := len(backwards) - 1; i >= 0; i-- {
+		cycle = append(cycle, backwards[i])
+	}
+	return cycle
+}
+
+// StronglyConnectedComponents returns the groups of nodes that can all reach each other, the components of an
+// undirected graph are its connected components. The components are returned in reverse topological order.
+func (g *Graph[N, W]) StronglyConnectedComponents() [][]N {
+	t := &tarjan[N, W]{
+		graph:   g,
+		indexes: collections.Dictionary[N, int]{},
+		lowLink: collections.Dictionary[N, int]{},
+		stacked: collections.NewValueSet[N](),
+	}
+	for n := range g.successors {
+		if !t.indexes.Has(n) {
+			t.visit(n)
+		}
+	}
+	return t.components
+}
+
+type tarjan[N comparable, W Weight] struct {
+	graph      *Graph[N, W]
+	indexes    collections.Dictionary[N, int]
+	lowLink    collections.Dictionary[N, int]
+	stack      []N
+	stacked    *collections.ValueSet[N]
+	components [][]N
+}
+
+func (t *tarjan[N, W]) visit(n N) {
+	index := t.indexes.Size()
+	t.indexes.Set(n, index)
+	t.lowLink.Set(n, index)
+	t.stack = append(t.stack, n)
+	t.stacked.Add(n)
+
+	for to := range t.graph.successors[n] {
+		if !t.indexes.Has(to) {
+			t.visit(to)
+			if t.lowLink[to] < t.lowLink[n] {
+				t.lowLink[n] = t.lowLink[to]
+			}
+		} else if t.stacked.Contains(to) && t.indexes[to] < t.lowLink[n] {
+			t.lowLink[n] = t.indexes[to]
+		}
+	}
+
+	if t.lowLink[n] != index {
+		return
+	}
+	var component []N
+	for {
+		last := t.stack[len(t.stack)-1]
+		t.stack = t.stack[:len(t.stack)-1]
+		t.stacked.Remove(last)
+		component = append(component, last)
+		if last == n {
+			break
+		}
+	}
+	t.components = append(t.components, component)
+}
@@ -0,0 +1,87 @@
+package graph
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"sort"
+	"testing"
+)
+
+func TestGraph_TopologicalSort(t *testing.T) {
+	g := NewDirected[string, int]()
+	g.AddEdge("schema", "users", 1)
+	g.AddEdge("schema", "orders", 1)
+	g.AddEdge("users", "orders", 1)
+	g.AddEdge("orders", "reports", 1)
+	g.AddNode("settings")
+
+	order, err := g.TopologicalSort()
+	assert.NoError(t, err)
+	assert.Len(t, order, 5)
+
+	positions := map[string]int{}
+	for i, n := range order {
+		positions[n] = i
+	}
+	for _, e := range g.Edges() {
+		assert.Less(t, positions[e.From()], positions[e.To()])
+	}
+}
+
+func TestGraph_TopologicalSort_Cycle(t *testing.T) {
+	g := NewDirected[string, int]()
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("b", "c", 1)
+	g.AddEdge("c", "d", 1)
+	g.AddEdge("d", "b", 1)
+	g.AddEdge("d", "e", 1)
+
+	_, err := g.TopologicalSort()
+	assert.ErrorIs(t, err, ErrCycle)
+
+	var cycleErr *CycleError[string]
+	assert.True(t, errors.As(err, &cycleErr))
+	cycle := cycleErr.Cycle()
+	assert.Len(t, cycle, 4)
+	assert.Equal(t, cycle[0], cycle[len(cycle)-1])
+	for i := 1; i < len(cycle); i++ {
+		assert.True(t, g.HasEdge(cycle[i-1], cycle[i]))
+	}
+
+	_, err = NewUndirected[string, int]().TopologicalSort()
+	assert.ErrorIs(t, err, ErrUndirectedGraph)
+}
+
+func TestGraph_StronglyConnectedComponents(t *testing.T) {
+	tests := []struct {
+		name     string
+		graph    *Graph[int, int]
+		expected [][]int
+	}{
+		{name: "Directed", graph: NewDirected[int, int](), expected: [][]int{{1, 2, 3}, {4, 5}, {6}, {7}}},
+		{name: "Undirected", graph: NewUndirected[int, int](), expected: [][]int{{1, 2, 3, 4, 5, 6}, {7}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := tt.graph
+			g.AddEdge(1, 2, 1)
+			g.AddEdge(2, 3, 1)
+			g.AddEdge(3, 1, 1)
+			g.AddEdge(3, 4, 1)
+			g.AddEdge(4, 5, 1)
+			g.AddEdge(5, 4, 1)
+			g.AddEdge(5, 6, 1)
+			g.AddNode(7)
+
+			components := g.StronglyConnectedComponents()
+			for _, c := range components {
+				sort.Ints(c)
+			}
+			sort.Slice(components, func(i, j int) bool {
+				return components[i][0] < components[j][0]
+			})
+			assert.Equal(t, tt.expected, components)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package graph
+
+import (
+	"container/heap"
+)
+
+type (
+	edgeQueue[N comparable, W Weight] []*Edge[N, W]
+)
+
+// MinimumSpanningTree returns an undirected graph with all the nodes and the edges with the least total weight that
+// connect them, using Prim's algorithm. A disconnected graph produces a spanning forest with a tree per connected
+// component. It returns ErrDirectedGraph if the graph is directed.
+func (g *Graph[N, W]) MinimumSpanningTree() (*Graph[N, W], error) {
+	if g.directed {
+		return nil, ErrDirectedGraph
+	}
+
+	tree := NewUndirected[N, W]()
+	for start := range g.successors {
+		if !tree.AddNode(start) {
+			continue
+		}
+
+		queue := &edgeQueue[N, W]{}
+		g.pushEdges(queue, start)
+		for queue.Len() > 0 {
+			e := heap.Pop(queue).(*Edge[N, W])
+			if tree.HasNode(e.to) {
+				continue
+			}
+			tree.AddEdge(e.from, e.to, e.weight)
+			g.pushEdges(queue, e.to)
+		}
+	}
+	return tree, nil
+}
+
+func (g *Graph[N, W]) pushEdges(queue *edgeQueue[N, W], from N) {
+	for to, weight := range g.successors[from] {
+		heap.Push(queue, &Edge[N, W]{from: from, to: to, weight: weight})
+	}
+}
+
+func (q edgeQueue[N, W]) Len() int {
+	return len(q)
+}
+
+func (q edgeQueue[N, W]) Less(i, j int) bool {
+	return q[i].weight < q[j].weight
+}
+
+func (q edgeQueue[N, W]) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+}
+
+func (q *edgeQueue[N, W]) Push(x any) {
+	*q = append(*q, x.(*Edge[N, W]))
+}
+
+func (q *edgeQueue[N, W]) Pop() any {
+	old := *q
+	e := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return e
+}
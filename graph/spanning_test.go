@@ -0,0 +1,33 @@
+package graph
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGraph_MinimumSpanningTree(t *testing.T) {
+	g := NewUndirected[string, int]()
+	g.AddEdge("a", "b", 4)
+	g.AddEdge("a", "c", 1)
+	g.AddEdge("b", "c", 2)
+	g.AddEdge("b", "d", 5)
+	g.AddEdge("c", "d", 8)
+	g.AddEdge("d", "e", 3)
+	g.AddEdge("x", "y", 7)
+	g.AddNode("z")
+
+	tree, err := g.MinimumSpanningTree()
+	assert.NoError(t, err)
+	assert.Equal(t, g.Order(), tree.Order())
+	assert.Equal(t, 5, tree.Size())
+
+	total := 0
+	for _, e := range tree.Edges() {
+		assert.True(t, g.HasEdge(e.From(), e.To()))
+		total += e.Weight()
+	}
+	assert.Equal(t, 18, total)
+
+	_, err = NewDirected[string, int]().MinimumSpanningTree()
+	assert.ErrorIs(t, err, ErrDirectedGraph)
+}
@@ -0,0 +1,82 @@
+package graph
+
+import (
+	"github.com/ovargas/go-lib/collections"
+)
+
+type (
+	// traversal is a lazy breadth-first or depth-first iterator over the nodes reachable from a start node.
+	traversal[N comparable, W Weight] struct {
+		graph   *Graph[N, W]
+		pending []N
+		visited *collections.ValueSet[N]
+		depth   bool
+	}
+)
+
+// BFS returns an iterator over the nodes reachable from the start node in breadth-first order, starting with the start
+// node. The iterator is empty if the start node does not exist.
+func (g *Graph[N, W]) BFS(start N) collections.Iterator[N] {
+	return g.traverse(start, false)
+}
+
+// DFS returns an iterator over the nodes reachable from the start node in depth-first pre-order, starting with the
+// start node. The iterator is empty if the start node does not exist.
+func (g *Graph[N, W]) DFS(start N) collections.Iterator[N] {
+	return g.traverse(start, true)
+}
+
+func (g *Graph[N, W]) traverse(start N, depth bool) *traversal[N, W] {
+	t := &traversal[N, W]{
+		graph:   g,
+		visited: collections.NewValueSet[N](),
+		depth:   depth,
+	}
+	if g.HasNode(start) {
+		t.pending = append(t.pending, start)
+	}
+	return t
+}
+
+// HasNext returns true if there are more nodes to visit.
+func (t *traversal[N, W]) HasNext() bool {
+	t.skipVisited()
+	return len(t.pending) > 0
+}
+
+// Next returns the next node, it panics if there are no more nodes to visit.
+func (t *traversal[N, W]) Next() N {
+	t.skipVisited()
+	n := t.pop()
+	t.visited.Add(n)
+	for to := range t.graph.successors[n] {
+		if !t.visited.Contains(to) {
+			t.pending = append(t.pending, to)
+		}
+	}
+	return n
+}
+
+// skipVisited drops the pending nodes that were visited after being queued.
+func (t *traversal[N, W]) skipVisited() {
+	for len(t.pending) > 0 && t.visited.Contains(t.peek()) {
+		t.pop()
+	}
+}
+
+func (t *traversal[N, W]) peek() N {
+	if t.depth {
+		return t.pending[len(t.pending)-1]
+	}
+	return t.pending[0]
+}
+
+func (t *traversal[N, W]) pop() N {
+	n := t.peek()
+	if t.depth {
+		t.pending = t.pending[:len(t.pending)-1]
+	} else {
+		t.pending = t.pending[1:]
+	}
+	return n
+}
@@ -0,0 +1,53 @@
+package graph
+
+import (
+	"github.com/ovargas/go-lib/collections"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func collect[N any](it collections.Iterator[N]) []N {
+	var nodes []N
+	for it.HasNext() {
+		nodes = append(nodes, it.Next())
+	}
+	return nodes
+}
+
+func TestGraph_BFS(t *testing.T) {
+	g := NewDirected[int, int]()
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(1, 3, 1)
+	g.AddEdge(2, 4, 1)
+	g.AddEdge(3, 4, 1)
+	g.AddEdge(4, 5, 1)
+	g.AddEdge(5, 1, 1)
+	g.AddEdge(6, 1, 1)
+
+	nodes := collect(g.BFS(1))
+
+	assert.Len(t, nodes, 5)
+	assert.Equal(t, 1, nodes[0])
+	assert.ElementsMatch(t, []int{2, 3}, nodes[1:3])
+	assert.Equal(t, []int{4, 5}, nodes[3:])
+	assert.Empty(t, collect(g.BFS(7)))
+}
+
+func TestGraph_DFS(t *testing.T) {
+	g := NewUndirected[string, int]()
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("b", "c", 1)
+	g.AddEdge("a", "d", 1)
+	g.AddEdge("d", "e", 1)
+	g.AddNode("f")
+
+	nodes := collect(g.DFS("a"))
+
+	assert.Len(t, nodes, 5)
+	assert.Equal(t, "a", nodes[0])
+	if nodes[1] == "b" {
+		assert.Equal(t, []string{"b", "c", "d", "e"}, nodes[1:])
+	} else {
+		assert.Equal(t, []string{"d", "e", "b", "c"}, nodes[1:])
+	}
+}
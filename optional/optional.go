@@ -5,6 +5,7 @@ import "reflect"
 type (
 	Optional[T any] struct {
 		value T
+		empty bool
 	}
 )
 
@@ -23,6 +24,9 @@ func OfNullable[T any](value T) *Optional[T] {
 
 // IsPresent returns true if the Optional contains a value.
 func (o *Optional[T]) IsPresent() bool {
+	if o.empty {
+		return false
+	}
 
 	if reflect.ValueOf(o.value).Kind() == reflect.Ptr {
 		return !reflect.ValueOf(o.value).IsNil()
@@ -70,7 +74,7 @@ func (o *Optional[T]) OrElseGet(f func() T) T {
 
 // Empty returns an empty Optional.
 func Empty[T any]() *Optional[T] {
-	return &Optional[T]{}
+	return &Optional[T]{empty: true}
 }
 
 // Map returns an Optional with the result of the given function applied to the value of the Optional.
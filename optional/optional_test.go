@@ -263,3 +263,9 @@ func Test_Map(t *testing.T) {
 		})
 	}
 }
+
+func TestEmpty(t *testing.T) {
+	assert.False(t, Empty[string]().IsPresent())
+	assert.False(t, Empty[*string]().IsPresent())
+	assert.Equal(t, 1, Empty[int]().OrElse(1))
+}
@@ -0,0 +1,125 @@
+package probabilistic
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+)
+
+type (
+
+	// BloomFilter is a set membership test that uses a fixed amount of memory. It never reports false negatives and
+	// reports false positives at the rate it was configured for, as long as it holds no more than the expected elements.
+	BloomFilter struct {
+		bits   []uint64
+		m      uint64
+		hashes uint64
+	}
+)
+
+const bloomMagic = 'B'
+
+// NewBloomFilter returns a BloomFilter sized for the expected number of elements and the false positive rate, which
+// must be between 0 and 1.
+func NewBloomFilter(expected uint64, falsePositiveRate float64) (*BloomFilter, error) {
+	if expected == 0 || falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		return nil, ErrInvalidParameter
+	}
+	m := math.Ceil(-float64(expected) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2))
+	k := math.Max(1, math.Round(m/float64(expected)*math.Ln2))
+	return newBloomFilter(uint64(m), uint64(k)), nil
+}
+
+func newBloomFilter(m, k uint64) *BloomFilter {
+	return &BloomFilter{bits: make([]uint64, (m+63)/64), m: m, hashes: k}
+}
+
+// Add adds the data to the filter.
+func (b *BloomFilter) Add(data []byte) {
+	h1, h2 := hash(data)
+	for i := uint64(0); i < b.hashes; i++ {
+		p := (h1 + i*h2) % b.m
+		b.bits[p/64] |= 1 << (p % 64)
+	}
+}
+
+// AddString adds the string to the filter.
+func (b *BloomFilter) AddString(s string) {
+	b.Add([]byte(s))
+}
+
+// MightContain returns false if the data was never added to the filter, and true if it probably was.
+func (b *BloomFilter) MightContain(data []byte) bool {
+	h1, h2 := hash(data)
+	for i := uint64(0); i < b.hashes; i++ {
+		p := (h1 + i*h2) % b.m
+		if b.bits[p/64]&(1<<(p%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// MightContainString returns false if the string was never added to the filter, and true if it probably was.
+func (b *BloomFilter) MightContainString(s string) bool {
+	return b.MightContain([]byte(s))
+}
+
+// Union adds the elements of the other filter to this filter, both filters must have been created with the same
+// parameters or ErrIncompatible is returned.
+func (b *BloomFilter) Union(other *BloomFilter) error {
+	if b.m != other.m || b.hashes != other.hashes {
+		return ErrIncompatible
+	}
+	for i, w := range other.bits {
+		b.bits[i] |= w
+	}
+	return nil
+}
+
+// EstimatedCount returns an estimation of the number of distinct elements added to the filter.
+func (b *BloomFilter) EstimatedCount() uint64 {
+	set := 0
+	for _, w := range b.bits {
+		set += bits.OnesCount64(w)
+	}
+	if uint64(set) == b.m {
+		return math.MaxUint64
+	}
+	m, k := float64(b.m), float64(b.hashes)
+	return uint64(math.Round(-m / k * math.Log(1-float64(set)/m)))
+}
+
+// MarshalBinary encodes the filter.
+func (b *BloomFilter) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 1, 1+16+8*len(b.bits))
+	data[0] = bloomMagic
+	data = binary.BigEndian.AppendUint64(data, b.m)
+	data = binary.BigEndian.AppendUint64(data, b.hashes)
+	for _, w := range b.bits {
+		data = binary.BigEndian.AppendUint64(data, w)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary replaces the filter with a filter encoded by MarshalBinary.
+func (b *BloomFilter) UnmarshalBinary(data []byte) error {
+	data, err := header(data, bloomMagic, 16)
+	if err != nil {
+		return err
+	}
+	m, k := binary.BigEndian.Uint64(data[0:8]), binary.BigEndian.Uint64(data[8:16])
+	data = data[16:]
+	// m is checked against the number of words in the payload without computing its size, which could overflow,
+	// and k is bounded by m as NewBloomFilter never uses more hashes than bits.
+	words := uint64(len(data) / 8)
+	if len(data)%8 != 0 || m == 0 || (m-1)/64+1 != words || k == 0 || k > m {
+		return ErrInvalidData
+	}
+	f := newBloomFilter(m, k)
+	for i := range f.bits {
+		f.bits[i] = binary.BigEndian.Uint64(data[i*8:])
+	}
+	*b = *f
+	return nil
+}
@@ -0,0 +1,104 @@
+package probabilistic
+
+import (
+	"encoding/binary"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestNewBloomFilter_InvalidParameters(t *testing.T) {
+	for _, rate := range []float64{0, 1, -0.1} {
+		_, err := NewBloomFilter(100, rate)
+		assert.ErrorIs(t, err, ErrInvalidParameter)
+	}
+	_, err := NewBloomFilter(0, 0.01)
+	assert.ErrorIs(t, err, ErrInvalidParameter)
+}
+
+func TestBloomFilter_MightContain(t *testing.T) {
+	const n = 10000
+	filter, err := NewBloomFilter(n, 0.01)
+	assert.NoError(t, err)
+
+	for i := 0; i < n; i++ {
+		filter.AddString(strconv.Itoa(i))
+	}
+	for i := 0; i < n; i++ {
+		assert.True(t, filter.MightContainString(strconv.Itoa(i)))
+	}
+
+	falsePositives := 0
+	for i := n; i < 2*n; i++ {
+		if filter.MightContainString(strconv.Itoa(i)) {
+			falsePositives++
+		}
+	}
+	assert.Less(t, float64(falsePositives)/n, 0.02)
+	assert.InEpsilon(t, n, filter.EstimatedCount(), 0.05)
+}
+
+func TestBloomFilter_Union(t *testing.T) {
+	a, _ := NewBloomFilter(100, 0.01)
+	b, _ := NewBloomFilter(100, 0.01)
+	a.AddString("a")
+	b.AddString("b")
+
+	assert.NoError(t, a.Union(b))
+	assert.True(t, a.MightContainString("a"))
+	assert.True(t, a.MightContainString("b"))
+
+	c, _ := NewBloomFilter(1000, 0.01)
+	assert.ErrorIs(t, a.Union(c), ErrIncompatible)
+}
+
+func TestBloomFilter_Binary(t *testing.T) {
+	filter, _ := NewBloomFilter(100, 0.01)
+	filter.Add([]byte("a"))
+
+	data, err := filter.MarshalBinary()
+	assert.NoError(t, err)
+
+	var actual BloomFilter
+	assert.NoError(t, actual.UnmarshalBinary(data))
+	assert.Equal(t, filter, &actual)
+	assert.True(t, actual.MightContain([]byte("a")))
+
+	assert.ErrorIs(t, actual.UnmarshalBinary(data[:len(data)-1]), ErrInvalidData)
+	assert.ErrorIs(t, actual.UnmarshalBinary([]byte("H")), ErrInvalidData)
+}
+
+func TestBloomFilter_UnmarshalBinary_Corrupt(t *testing.T) {
+	encode := func(m, k uint64, words int) []byte {
+		data := []byte{bloomMagic}
+		data = binary.BigEndian.AppendUint64(data, m)
+		data = binary.BigEndian.AppendUint64(data, k)
+		return append(data, make([]byte, words*8)...)
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"ZeroBits", encode(0, 1, 0)},
+		{"ZeroHashes", encode(64, 0, 1)},
+		{"MoreHashesThanBits", encode(64, 65, 1)},
+		{"MissingWords", encode(65, 1, 1)},
+		{"ExtraWords", encode(64, 1, 2)},
+		{"PartialWord", encode(64, 1, 1)[:1+16+7]},
+		{"OverflowingSize", encode(math.MaxUint64, 1, 0)},
+		{"HugeSize", encode(math.MaxUint64-63, 1, 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var actual BloomFilter
+			assert.ErrorIs(t, actual.UnmarshalBinary(tt.data), ErrInvalidData)
+		})
+	}
+
+	var actual BloomFilter
+	assert.NoError(t, actual.UnmarshalBinary(encode(65, 3, 2)))
+	actual.AddString("a")
+	assert.True(t, actual.MightContainString("a"))
+}
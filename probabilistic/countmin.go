@@ -0,0 +1,118 @@
+package probabilistic
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+type (
+
+	// CountMinSketch estimates the frequency of elements using a fixed amount of memory. Estimates are never lower
+	// than the true frequency and exceed it by at most epsilon times the total count, with probability 1 - delta.
+	CountMinSketch struct {
+		counters []uint64
+		width    uint64
+		depth    uint64
+		total    uint64
+	}
+)
+
+const countMinMagic = 'C'
+
+// NewCountMinSketch returns a CountMinSketch with the error bound epsilon and the failure probability delta, both
+// between 0 and 1.
+func NewCountMinSketch(epsilon, delta float64) (*CountMinSketch, error) {
+	if epsilon <= 0 || epsilon >= 1 || delta <= 0 || delta >= 1 {
+		return nil, ErrInvalidParameter
+	}
+	width := uint64(math.Ceil(math.E / epsilon))
+	depth := uint64(math.Ceil(math.Log(1 / delta)))
+	return newCountMinSketch(width, depth), nil
+}
+
+func newCountMinSketch(width, depth uint64) *CountMinSketch {
+	return &CountMinSketch{counters: make([]uint64, width*depth), width: width, depth: depth}
+}
+
+// Add adds count occurrences of the data.
+func (c *CountMinSketch) Add(data []byte, count uint64) {
+	h1, h2 := hash(data)
+	for i := uint64(0); i < c.depth; i++ {
+		c.counters[i*c.width+(h1+i*h2)%c.width] += count
+	}
+	c.total += count
+}
+
+// AddString adds count occurrences of the string.
+func (c *CountMinSketch) AddString(s string, count uint64) {
+	c.Add([]byte(s), count)
+}
+
+// Estimate returns the estimated number of occurrences of the data.
+func (c *CountMinSketch) Estimate(data []byte) uint64 {
+	h1, h2 := hash(data)
+	estimate := uint64(math.MaxUint64)
+	for i := uint64(0); i < c.depth; i++ {
+		if v := c.counters[i*c.width+(h1+i*h2)%c.width]; v < estimate {
+			estimate = v
+		}
+	}
+	return estimate
+}
+
+// EstimateString returns the estimated number of occurrences of the string.
+func (c *CountMinSketch) EstimateString(s string) uint64 {
+	return c.Estimate([]byte(s))
+}
+
+// Total returns the total number of occurrences added to the sketch.
+func (c *CountMinSketch) Total() uint64 {
+	return c.total
+}
+
+// Merge adds the occurrences of the other sketch to this sketch, both sketches must have been created with the same
+// parameters or ErrIncompatible is returned.
+func (c *CountMinSketch) Merge(other *CountMinSketch) error {
+	if c.width != other.width || c.depth != other.depth {
+		return ErrIncompatible
+	}
+	for i, v := range other.counters {
+		c.counters[i] += v
+	}
+	c.total += other.total
+	return nil
+}
+
+// MarshalBinary encodes the sketch.
+func (c *CountMinSketch) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 1, 1+24+8*len(c.counters))
+	data[0] = countMinMagic
+	data = binary.BigEndian.AppendUint64(data, c.width)
+	data = binary.BigEndian.AppendUint64(data, c.depth)
+	data = binary.BigEndian.AppendUint64(data, c.total)
+	for _, v := range c.counters {
+		data = binary.BigEndian.AppendUint64(data, v)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary replaces the sketch with a sketch encoded by MarshalBinary.
+func (c *CountMinSketch) UnmarshalBinary(data []byte) error {
+	data, err := header(data, countMinMagic, 24)
+	if err != nil {
+		return err
+	}
+	width, depth := binary.BigEndian.Uint64(data[0:8]), binary.BigEndian.Uint64(data[8:16])
+	total := binary.BigEndian.Uint64(data[16:24])
+	data = data[24:]
+	if width == 0 || depth == 0 || uint64(len(data))/8/depth != width || uint64(len(data)) != width*depth*8 {
+		return ErrInvalidData
+	}
+	s := newCountMinSketch(width, depth)
+	for i := range s.counters {
+		s.counters[i] = binary.BigEndian.Uint64(data[i*8:])
+	}
+	s.total = total
+	*c = *s
+	return nil
+}
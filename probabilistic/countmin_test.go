@@ -0,0 +1,53 @@
+package probabilistic
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+func TestCountMinSketch_Estimate(t *testing.T) {
+	sketch, err := NewCountMinSketch(0.001, 0.01)
+	assert.NoError(t, err)
+
+	for i := 0; i < 1000; i++ {
+		sketch.AddString(strconv.Itoa(i), uint64(i%10+1))
+	}
+	sketch.AddString("hot", 5000)
+
+	assert.GreaterOrEqual(t, sketch.EstimateString("hot"), uint64(5000))
+	assert.LessOrEqual(t, sketch.EstimateString("hot"), uint64(5000+0.001*float64(sketch.Total())))
+	for i := 0; i < 1000; i++ {
+		assert.GreaterOrEqual(t, sketch.EstimateString(strconv.Itoa(i)), uint64(i%10+1))
+	}
+	assert.Equal(t, uint64(10500), sketch.Total())
+}
+
+func TestCountMinSketch_Merge(t *testing.T) {
+	a, _ := NewCountMinSketch(0.01, 0.01)
+	b, _ := NewCountMinSketch(0.01, 0.01)
+	a.AddString("x", 2)
+	b.AddString("x", 3)
+
+	assert.NoError(t, a.Merge(b))
+	assert.Equal(t, uint64(5), a.EstimateString("x"))
+	assert.Equal(t, uint64(5), a.Total())
+
+	c, _ := NewCountMinSketch(0.1, 0.01)
+	assert.ErrorIs(t, a.Merge(c), ErrIncompatible)
+	_, err := NewCountMinSketch(0, 0.01)
+	assert.ErrorIs(t, err, ErrInvalidParameter)
+}
+
+func TestCountMinSketch_Binary(t *testing.T) {
+	sketch, _ := NewCountMinSketch(0.01, 0.01)
+	sketch.Add([]byte("x"), 7)
+
+	data, err := sketch.MarshalBinary()
+	assert.NoError(t, err)
+
+	var actual CountMinSketch
+	assert.NoError(t, actual.UnmarshalBinary(data))
+	assert.Equal(t, sketch, &actual)
+	assert.ErrorIs(t, actual.UnmarshalBinary(data[:30]), ErrInvalidData)
+}
@@ -0,0 +1,40 @@
+package probabilistic
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/fnv"
+)
+
+var (
+	ErrInvalidParameter = errors.New("invalid parameter")
+	ErrIncompatible     = errors.New("incompatible structures")
+	ErrInvalidData      = errors.New("invalid encoded data")
+)
+
+// hash returns two independent 64-bit hashes of the data. The hashes do not depend on the process, so structures
+// can be serialized and merged across processes.
+func hash(data []byte) (uint64, uint64) {
+	h := fnv.New128a()
+	_, _ = h.Write(data)
+	sum := h.Sum(nil)
+	return mix64(binary.BigEndian.Uint64(sum[:8])), mix64(binary.BigEndian.Uint64(sum[8:]))
+}
+
+// mix64 is the splitmix64 finalizer, it spreads the bits of the FNV hash across the whole word.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// header checks that the data starts with the magic byte of a structure and returns the rest of the data.
+func header(data []byte, magic byte, size int) ([]byte, error) {
+	if len(data) < 1+size || data[0] != magic {
+		return nil, ErrInvalidData
+	}
+	return data[1:], nil
+}
@@ -0,0 +1,109 @@
+package probabilistic
+
+import (
+	"math"
+	"math/bits"
+)
+
+type (
+
+	// HyperLogLog estimates the number of distinct elements using 2^precision bytes of memory, with a standard error
+	// of about 1.04 / sqrt(2^precision).
+	HyperLogLog struct {
+		registers []uint8
+		precision uint8
+	}
+)
+
+const hyperLogLogMagic = 'H'
+
+// NewHyperLogLog returns a HyperLogLog with the precision, which must be between 4 and 18.
+func NewHyperLogLog(precision uint8) (*HyperLogLog, error) {
+	if precision < 4 || precision > 18 {
+		return nil, ErrInvalidParameter
+	}
+	return &HyperLogLog{registers: make([]uint8, 1<<precision), precision: precision}, nil
+}
+
+// Add adds the data to the estimation.
+func (h *HyperLogLog) Add(data []byte) {
+	x, _ := hash(data)
+	i := x >> (64 - h.precision)
+	// The guard bit bounds the rank when the remaining bits are all zero.
+	w := x<<h.precision | 1<<(h.precision-1)
+	if rank := uint8(bits.LeadingZeros64(w) + 1); rank > h.registers[i] {
+		h.registers[i] = rank
+	}
+}
+
+// AddString adds the string to the estimation.
+func (h *HyperLogLog) AddString(s string) {
+	h.Add([]byte(s))
+}
+
+// Count returns the estimated number of distinct elements added.
+func (h *HyperLogLog) Count() uint64 {
+	m := float64(len(h.registers))
+	sum, zeros := 0.0, 0
+	for _, r := range h.registers {
+		sum += 1 / float64(uint64(1)<<r)
+		if r == 0 {
+			zeros++
+		}
+	}
+
+	estimate := alpha(len(h.registers)) * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		// Linear counting is more accurate for small cardinalities.
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return uint64(math.Round(estimate))
+}
+
+// Merge adds the elements of the other estimation to this estimation, both must have the same precision or
+// ErrIncompatible is returned.
+func (h *HyperLogLog) Merge(other *HyperLogLog) error {
+	if h.precision != other.precision {
+		return ErrIncompatible
+	}
+	for i, r := range other.registers {
+		if r > h.registers[i] {
+			h.registers[i] = r
+		}
+	}
+	return nil
+}
+
+// MarshalBinary encodes the estimation.
+func (h *HyperLogLog) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 2, 2+len(h.registers))
+	data[0], data[1] = hyperLogLogMagic, h.precision
+	return append(data, h.registers...), nil
+}
+
+// UnmarshalBinary replaces the estimation with an estimation encoded by MarshalBinary.
+func (h *HyperLogLog) UnmarshalBinary(data []byte) error {
+	data, err := header(data, hyperLogLogMagic, 1)
+	if err != nil {
+		return err
+	}
+	result, err := NewHyperLogLog(data[0])
+	if err != nil || len(data)-1 != len(result.registers) {
+		return ErrInvalidData
+	}
+	copy(result.registers, data[1:])
+	*h = *result
+	return nil
+}
+
+func alpha(m int) float64 {
+	switch m {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	}
+	return 0.7213 / (1 + 1.079/float64(m))
+}
@@ -0,0 +1,59 @@
+package probabilistic
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+func TestHyperLogLog_Count(t *testing.T) {
+	for _, n := range []int{0, 10, 1000, 100000} {
+		t.Run(strconv.Itoa(n), func(t *testing.T) {
+			hll, err := NewHyperLogLog(14)
+			assert.NoError(t, err)
+
+			for i := 0; i < n; i++ {
+				hll.AddString(strconv.Itoa(i))
+				hll.AddString(strconv.Itoa(i))
+			}
+
+			if n == 0 {
+				assert.Zero(t, hll.Count())
+				return
+			}
+			assert.InEpsilon(t, n, hll.Count(), 0.03)
+		})
+	}
+}
+
+func TestHyperLogLog_Merge(t *testing.T) {
+	a, _ := NewHyperLogLog(12)
+	b, _ := NewHyperLogLog(12)
+	for i := 0; i < 6000; i++ {
+		a.AddString(strconv.Itoa(i))
+	}
+	for i := 4000; i < 10000; i++ {
+		b.AddString(strconv.Itoa(i))
+	}
+
+	assert.NoError(t, a.Merge(b))
+	assert.InEpsilon(t, 10000, a.Count(), 0.05)
+
+	c, _ := NewHyperLogLog(10)
+	assert.ErrorIs(t, a.Merge(c), ErrIncompatible)
+	_, err := NewHyperLogLog(3)
+	assert.ErrorIs(t, err, ErrInvalidParameter)
+}
+
+func TestHyperLogLog_Binary(t *testing.T) {
+	hll, _ := NewHyperLogLog(8)
+	hll.Add([]byte("x"))
+
+	data, err := hll.MarshalBinary()
+	assert.NoError(t, err)
+
+	var actual HyperLogLog
+	assert.NoError(t, actual.UnmarshalBinary(data))
+	assert.Equal(t, hll, &actual)
+	assert.ErrorIs(t, actual.UnmarshalBinary(data[:10]), ErrInvalidData)
+}
@@ -1,6 +1,11 @@
 package slice
 
-import "fmt"
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ovargas/go-lib/compare"
+)
 
 type (
 	Predicate[T any] func(T) bool
@@ -46,3 +51,36 @@ func Filter[T any](slice []T, predicate func(T) bool) []T {
 	}
 	return result
 }
+
+// Sort sorts a slice in place using the comparator, the sort is not guaranteed to be stable
+func Sort[T any](slice []T, c compare.Comparator[T]) {
+	sort.Slice(slice, func(i, j int) bool {
+		return c(slice[i], slice[j]) < 0
+	})
+}
+
+// SortStable sorts a slice in place using the comparator, keeping the original order of equal elements
+func SortStable[T any](slice []T, c compare.Comparator[T]) {
+	sort.SliceStable(slice, func(i, j int) bool {
+		return c(slice[i], slice[j]) < 0
+	})
+}
+
+// IsSorted returns true if the slice is sorted according to the comparator
+func IsSorted[T any](slice []T, c compare.Comparator[T]) bool {
+	for i := 1; i < len(slice); i++ {
+		if c(slice[i-1], slice[i]) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// BinarySearch searches x in a slice sorted according to the comparator, it returns the position where x is found,
+// or where it would be inserted, and whether it was found
+func BinarySearch[T any](slice []T, x T, c compare.Comparator[T]) (int, bool) {
+	i := sort.Search(len(slice), func(i int) bool {
+		return c(slice[i], x) >= 0
+	})
+	return i, i < len(slice) && c(slice[i], x) == 0
+}